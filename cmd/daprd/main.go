@@ -14,6 +14,7 @@ limitations under the License.
 package main
 
 import (
+	"net"
 	"os"
 	"os/signal"
 	"strings"
@@ -167,6 +168,10 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "plugins" {
+		os.Exit(pluginsCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// set GOMAXPROCS
 	_, _ = maxprocs.Set()
 
@@ -513,18 +518,17 @@ func main() {
 		),
 
 		runtime.WithPlugins(
-			plugin_loader.New(modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
-				// inject the filesystem object into the plugin.
-				// depenency injection would be ideal for this
-				filesystem := os.DirFS("/")
-				return standalone.NewPlugin(logContrib, cfg, filesystem, standalone.DefaultClientProtocolFactory), nil
-			}),
-			plugin_loader.New(modes.KubernetesMode, func(cfg plugin.Config) (plugin.Plugin, error) {
-				// inject the discovery service into the plugin
-				// depenency injection would be ideal for this
-				environment := env.NewOS()
-				discovery := plugin_kubernetes.NewDiscovery(environment)
-				return plugin_kubernetes.NewPlugin(logContrib, cfg, discovery, plugin_kubernetes.DefaultConnectionFactory), nil
+			plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, execPlugin),
+			plugin_loader.New(plugin.TypeExec, modes.StandaloneMode, execPlugin),
+			plugin_loader.New(plugin.TypeGRPC, modes.KubernetesMode, containerPlugin),
+			plugin_loader.New(plugin.TypeContainer, modes.KubernetesMode, containerPlugin),
+			plugin_loader.New(plugin.TypeRemoteAddress, "", func(cfg plugin.Config) (plugin.Plugin, error) {
+				discovery := plugin_kubernetes.NewAddressDiscovery(cfg.Address)
+				return plugin_kubernetes.NewPlugin(logContrib, cfg, discovery, plugin_kubernetes.NewConnectionFactory(cfg, discovery)), nil
+			}),
+			plugin_loader.New(plugin.TypeService, modes.KubernetesMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+				discovery := plugin_kubernetes.NewServiceDiscovery(cfg.Namespace, net.DefaultResolver)
+				return plugin_kubernetes.NewPlugin(logContrib, cfg, discovery, plugin_kubernetes.NewConnectionFactory(cfg, discovery)), nil
 			}),
 		),
 	)
@@ -537,3 +541,23 @@ func main() {
 	<-stop
 	rt.ShutdownWithWait()
 }
+
+// execPlugin creates a plugin launched by the runtime from the plugins directory.
+func execPlugin(cfg plugin.Config) (plugin.Plugin, error) {
+	// inject the filesystem object into the plugin.
+	// depenency injection would be ideal for this
+	filesystem := os.DirFS(standalone.FilesystemRoot(cfg.Standalone.PluginsPath))
+	return standalone.NewPlugin(logContrib, cfg, filesystem, standalone.DefaultClientProtocolFactory), nil
+}
+
+// containerPlugin creates a plugin running in a container of the pod, located through the plugins discovery file when configured and then through the environment.
+func containerPlugin(cfg plugin.Config) (plugin.Plugin, error) {
+	// inject the discovery service into the plugin
+	// depenency injection would be ideal for this
+	environment := env.NewOS()
+	discovery := plugin_kubernetes.NewDiscovery(environment)
+	if cfg.Kubernetes.PluginsDiscoveryFile != "" {
+		discovery = plugin_kubernetes.NewChainDiscovery(plugin_kubernetes.NewFileDiscovery(cfg.Kubernetes.PluginsDiscoveryFile), discovery)
+	}
+	return plugin_kubernetes.NewPlugin(logContrib, cfg, discovery, plugin_kubernetes.NewConnectionFactory(cfg, discovery)), nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/plugin/standalone/packages"
+)
+
+const pluginsUsage = `usage:
+  daprd plugins install --plugins-path <path> <package directory or tarball>
+  daprd plugins list --plugins-path <path>
+  daprd plugins remove --plugins-path <path> <name> [version]
+`
+
+// pluginsCommand runs the plugins subcommand, which installs, lists and removes the plugins of a standalone plugins directory, and returns the exit code.
+func pluginsCommand(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || (args[0] != "install" && args[0] != "list" && args[0] != "remove") {
+		fmt.Fprint(stderr, pluginsUsage)
+		return 2
+	}
+	flags := flag.NewFlagSet("plugins "+args[0], flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	pluginsPath := flags.String("plugins-path", "", "Path for plugins directory")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if *pluginsPath == "" {
+		fmt.Fprintln(stderr, "--plugins-path is required")
+		return 2
+	}
+	manager := packages.NewManager(*pluginsPath)
+
+	var err error
+	switch {
+	case args[0] == "install" && flags.NArg() == 1:
+		err = installPlugin(manager, flags.Arg(0), stdout)
+	case args[0] == "list" && flags.NArg() == 0:
+		err = listPlugins(manager, stdout)
+	case args[0] == "remove" && flags.NArg() == 1:
+		err = removePlugin(manager, flags.Arg(0), "", stdout)
+	case args[0] == "remove" && flags.NArg() == 2:
+		err = removePlugin(manager, flags.Arg(0), flags.Arg(1), stdout)
+	default:
+		fmt.Fprint(stderr, pluginsUsage)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func installPlugin(manager *packages.Manager, source string, stdout io.Writer) error {
+	manifest, err := manager.Install(source)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "installed plugin %s/%s\n", manifest.Name, manifest.Version)
+	return nil
+}
+
+func listPlugins(manager *packages.Manager, stdout io.Writer) error {
+	manifests, err := manager.List()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, "NAME\tVERSION\tRUNTIME\tCOMPONENTS")
+	for _, manifest := range manifests {
+		fmt.Fprintf(stdout, "%s\t%s\t%s\t%s\n", manifest.Name, manifest.Version, manifest.Runtime, strings.Join(manifest.ComponentTypes, ","))
+	}
+	return nil
+}
+
+func removePlugin(manager *packages.Manager, name, version string, stdout io.Writer) error {
+	if err := manager.Remove(name, version); err != nil {
+		return err
+	}
+	if version == "" {
+		fmt.Fprintf(stdout, "removed plugin %s\n", name)
+	} else {
+		fmt.Fprintf(stdout, "removed plugin %s/%s\n", name, version)
+	}
+	return nil
+}
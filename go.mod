@@ -65,6 +65,7 @@ require (
 	github.com/cenkalti/backoff v2.2.1+incompatible
 	github.com/go-logr/logr v0.3.0
 	github.com/hashicorp/go-plugin v1.4.3
+	github.com/robfig/cron/v3 v3.0.1
 )
 
 require (
@@ -247,7 +248,6 @@ require (
 	github.com/prometheus/procfs v0.7.3 // indirect
 	github.com/prometheus/statsd_exporter v0.22.3 // indirect
 	github.com/rcrowley/go-metrics v0.0.0-20181016184325-3113b8401b8a // indirect
-	github.com/robfig/cron/v3 v3.0.1 // indirect
 	github.com/rs/zerolog v1.25.0 // indirect
 	github.com/samuel/go-zookeeper v0.0.0-20190923202752-2cc03de413da // indirect
 	github.com/savsgio/gotils v0.0.0-20210217112953-d4a072536008 // indirect
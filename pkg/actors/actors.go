@@ -21,6 +21,7 @@ import (
 	nethttp "net/http"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -53,6 +54,8 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/retry"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 )
 
 const (
@@ -73,11 +76,13 @@ type Actors interface {
 	GetState(ctx context.Context, req *GetStateRequest) (*StateResponse, error)
 	TransactionalStateOperation(ctx context.Context, req *TransactionalRequest) error
 	GetReminder(ctx context.Context, req *GetReminderRequest) (*Reminder, error)
+	ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error)
 	CreateReminder(ctx context.Context, req *CreateReminderRequest) error
 	DeleteReminder(ctx context.Context, req *DeleteReminderRequest) error
 	RenameReminder(ctx context.Context, req *RenameReminderRequest) error
 	CreateTimer(ctx context.Context, req *CreateTimerRequest) error
 	DeleteTimer(ctx context.Context, req *DeleteTimerRequest) error
+	ListTimers(ctx context.Context, req *ListTimersRequest) (*ListTimersResponse, error)
 	IsActorHosted(ctx context.Context, req *ActorHostedRequest) bool
 	GetActiveActorsCount(ctx context.Context) []ActiveActorsCount
 }
@@ -105,6 +110,7 @@ type actorsRuntime struct {
 	tracingSpec              configuration.TracingSpec
 	reentrancyEnabled        bool
 	actorTypeMetadataEnabled bool
+	pubsubAdapter            runtime_pubsub.Adapter
 }
 
 // ActiveActorsCount contain actorType and count of actors each type has.
@@ -144,7 +150,8 @@ func NewActors(
 	config Config,
 	certChain *dapr_credentials.CertChain,
 	tracingSpec configuration.TracingSpec,
-	features []configuration.FeatureSpec) Actors {
+	features []configuration.FeatureSpec,
+	pubsubAdapter runtime_pubsub.Adapter) Actors {
 	var transactionalStore state.TransactionalStore
 	if stateStore != nil {
 		features := stateStore.Features()
@@ -173,8 +180,9 @@ func NewActors(
 		appHealthy:               atomic.NewBool(true),
 		certChain:                certChain,
 		tracingSpec:              tracingSpec,
-		reentrancyEnabled:        configuration.IsFeatureEnabled(features, configuration.ActorReentrancy) && config.Reentrancy.Enabled,
+		reentrancyEnabled:        configuration.IsFeatureEnabled(features, configuration.ActorReentrancy),
 		actorTypeMetadataEnabled: configuration.IsFeatureEnabled(features, configuration.ActorTypeMetadata),
+		pubsubAdapter:            pubsubAdapter,
 	}
 }
 
@@ -209,10 +217,15 @@ func (a *actorsRuntime) Init() error {
 		afterTableUpdateFn)
 
 	go a.placement.Start()
-	a.startDeactivationTicker(a.config.ActorDeactivationScanInterval, a.config.ActorIdleTimeout)
+	a.startDeactivationTicker(a.config.ActorDeactivationScanInterval)
 
 	log.Infof("actor runtime started. actor idle timeout: %s. actor scan interval: %s",
 		a.config.ActorIdleTimeout.String(), a.config.ActorDeactivationScanInterval.String())
+	for actorType, entityConfig := range a.config.EntitiesConfig {
+		log.Infof("actor type %s: actor idle timeout: %s. drain ongoing call timeout: %s. drain rebalanced actors: %t. reentrancy enabled: %t. reminders storage partitions: %d",
+			actorType, entityConfig.ActorIdleTimeout.String(), entityConfig.DrainOngoingCallTimeout.String(), entityConfig.DrainRebalancedActors,
+			entityConfig.Reentrancy.Enabled, entityConfig.RemindersStoragePartitions)
+	}
 
 	// Be careful to configure healthz endpoint option. If app healthz returns unhealthy status, Dapr will
 	// disconnect from placement to remove the node from consistent hashing ring.
@@ -279,7 +292,7 @@ func (a *actorsRuntime) getActorTypeAndIDFromKey(key string) (string, string) {
 	return arr[0], arr[1]
 }
 
-func (a *actorsRuntime) startDeactivationTicker(interval, actorIdleTimeout time.Duration) {
+func (a *actorsRuntime) startDeactivationTicker(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	go func() {
 		for t := range ticker.C {
@@ -291,7 +304,7 @@ func (a *actorsRuntime) startDeactivationTicker(interval, actorIdleTimeout time.
 				}
 
 				durationPassed := t.Sub(actorInstance.lastUsedTime)
-				if durationPassed >= actorIdleTimeout {
+				if durationPassed >= a.config.entityConfig(actorInstance.actorType).ActorIdleTimeout {
 					go func(actorKey string) {
 						actorType, actorID := a.getActorTypeAndIDFromKey(actorKey)
 						err := a.deactivateActor(actorType, actorID)
@@ -366,7 +379,7 @@ func (a *actorsRuntime) getOrCreateActor(actorType, actorID string) *actor {
 	// call newActor, but this is trivial.
 	val, ok := a.actorsTable.Load(key)
 	if !ok {
-		val, _ = a.actorsTable.LoadOrStore(key, newActor(actorType, actorID, a.config.Reentrancy.MaxStackDepth))
+		val, _ = a.actorsTable.LoadOrStore(key, newActor(actorType, actorID, a.config.entityConfig(actorType).Reentrancy.MaxStackDepth))
 	}
 
 	return val.(*actor)
@@ -379,7 +392,7 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 
 	// Reentrancy to determine how we lock.
 	var reentrancyID *string
-	if a.reentrancyEnabled {
+	if a.reentrancyEnabled && a.config.entityConfig(actorTypeID.GetActorType()).Reentrancy.Enabled {
 		if headerValue, ok := req.Metadata()["Dapr-Reentrancy-Id"]; ok {
 			reentrancyID = &headerValue.GetValues()[0]
 		} else {
@@ -453,7 +466,7 @@ func (a *actorsRuntime) GetState(ctx context.Context, req *GetStateRequest) (*St
 	metadata := map[string]string{metadataPartitionKey: partitionKey}
 
 	key := a.constructActorStateKey(req.ActorType, req.ActorID, req.Key)
-	resp, err := a.store.Get(&state.GetRequest{
+	resp, err := state_sdk.WithContext(ctx, a.store).Get(&state.GetRequest{
 		Key:      key,
 		Metadata: metadata,
 		Options: state.GetStateOption{
@@ -514,7 +527,7 @@ func (a *actorsRuntime) TransactionalStateOperation(ctx context.Context, req *Tr
 		}
 	}
 
-	err := a.transactionalStore.Multi(&state.TransactionalStateRequest{
+	err := state_sdk.WithTransactionalContext(ctx, a.transactionalStore).Multi(&state.TransactionalStateRequest{
 		Operations: operations,
 		Metadata:   metadata,
 	})
@@ -562,11 +575,12 @@ func (a *actorsRuntime) drainRebalancedActors() {
 				}
 
 				actor := value.(*actor)
-				if a.config.DrainRebalancedActors {
+				entityConfig := a.config.entityConfig(actorType)
+				if entityConfig.DrainRebalancedActors {
 					// wait until actor isn't busy or timeout hits
 					if actor.isBusy() {
 						select {
-						case <-time.After(a.config.DrainOngoingCallTimeout):
+						case <-time.After(entityConfig.DrainOngoingCallTimeout):
 							break
 						case <-actor.channel():
 							// if a call comes in from the actor for state changes, that's still allowed
@@ -685,7 +699,7 @@ func (a *actorsRuntime) getReminderTrack(actorKey, name string) (*ReminderTrack,
 	return &track, nil
 }
 
-func (a *actorsRuntime) updateReminderTrack(actorKey, name string, repetition int, lastInvokeTime time.Time) error {
+func (a *actorsRuntime) updateReminderTrack(actorKey, name string, repetition int, lastInvokeTime, nextInvokeTime time.Time) error {
 	if a.store == nil {
 		return errors.New("actors: state store does not exist or incorrectly configured")
 	}
@@ -694,6 +708,9 @@ func (a *actorsRuntime) updateReminderTrack(actorKey, name string, repetition in
 		LastFiredTime:  lastInvokeTime.Format(time.RFC3339),
 		RepetitionLeft: repetition,
 	}
+	if !nextInvokeTime.IsZero() {
+		track.NextFireTime = nextInvokeTime.UTC().Format(time.RFC3339)
+	}
 
 	err := a.store.Set(&state.SetRequest{
 		Key:   constructCompositeKey(actorKey, name),
@@ -711,8 +728,7 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 
 	var (
 		nextTime, ttl            time.Time
-		period                   time.Duration
-		years, months, days      int
+		sched                    = &schedule{}
 		repeats, repetitionsLeft int
 	)
 
@@ -728,7 +744,7 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 
 	repeats = -1 // set to default
 	if len(reminder.Period) != 0 {
-		if years, months, days, period, repeats, err = parseDuration(reminder.Period); err != nil {
+		if sched, repeats, err = parsePeriod(reminder.Period); err != nil {
 			return errors.Wrap(err, "error parsing reminder period")
 		}
 	}
@@ -738,19 +754,26 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 		return errors.Wrap(err, "error getting reminder track")
 	}
 
-	if track != nil && len(track.LastFiredTime) != 0 {
+	switch {
+	case track != nil && len(track.NextFireTime) != 0:
+		// the persisted next fire time is used as is, so the schedule does not drift when the reminder is resumed
+		if nextTime, err = time.Parse(time.RFC3339, track.NextFireTime); err != nil {
+			return errors.Wrap(err, "error parsing reminder next fire time")
+		}
+		repetitionsLeft = track.RepetitionLeft
+	case track != nil && len(track.LastFiredTime) != 0:
 		lastFiredTime, err := time.Parse(time.RFC3339, track.LastFiredTime)
 		if err != nil {
 			return errors.Wrap(err, "error parsing reminder last fired time")
 		}
 		repetitionsLeft = track.RepetitionLeft
-		nextTime = lastFiredTime.AddDate(years, months, days).Add(period)
-	} else {
+		nextTime = sched.next(lastFiredTime)
+	default:
 		repetitionsLeft = repeats
-		nextTime = registeredTime
+		nextTime = sched.first(registeredTime)
 	}
 
-	go func(reminder *Reminder, years int, months int, days int, period time.Duration, nextTime, ttl time.Time, repetitionsLeft int, stop chan bool) {
+	go func(reminder *Reminder, sched *schedule, nextTime, ttl time.Time, repetitionsLeft int, stop chan bool) {
 		var (
 			ttlTimer, nextTimer *time.Timer
 			ttlTimerC           <-chan time.Time
@@ -769,8 +792,11 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 				<-ttlTimerC
 			}
 		}()
+		if nextTime.IsZero() {
+			log.Infof("reminder %s has no fire time left", reminder.Name)
+		}
 	L:
-		for {
+		for !nextTime.IsZero() {
 			select {
 			case <-nextTimer.C:
 				// noop
@@ -794,21 +820,37 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 				log.Infof("reminder %q has completed %d repetitions", reminder.Name, repeats)
 				break L
 			}
-			if err = a.executeReminder(reminder); err != nil {
-				log.Errorf("error execution of reminder %q for actor type %s with id %s: %v",
-					reminder.Name, reminder.ActorType, reminder.ActorID, err)
+			if !a.deliverReminder(reminder, nextTime, stop) {
+				log.Infof("reminder %s has been deleted while retrying its delivery", reminder.Name)
+				return
 			}
 			if repetitionsLeft > 0 {
 				repetitionsLeft--
 			}
-			if err = a.updateReminderTrack(actorKey, reminder.Name, repetitionsLeft, nextTime); err != nil {
+			lastTime := nextTime
+			if sched.repeats() {
+				nextTime = sched.next(lastTime)
+			} else {
+				nextTime = time.Time{}
+			}
+			select {
+			case <-stop:
+				// the reminder has been deleted during the delivery, and its track with it
+				log.Infof("reminder %s has been deleted while being delivered", reminder.Name)
+				return
+			default:
+			}
+			if err = a.updateReminderTrack(actorKey, reminder.Name, repetitionsLeft, lastTime, nextTime); err != nil {
 				log.Errorf("error updating reminder track: %v", err)
 			}
 			// if reminder is not repetitive, proceed with reminder deletion
-			if years == 0 && months == 0 && days == 0 && period == 0 {
+			if !sched.repeats() {
+				break L
+			}
+			if nextTime.IsZero() {
+				log.Infof("reminder %s has no fire time left", reminder.Name)
 				break L
 			}
-			nextTime = nextTime.AddDate(years, months, days).Add(period)
 			if nextTimer.Stop() {
 				<-nextTimer.C
 			}
@@ -822,7 +864,7 @@ func (a *actorsRuntime) startReminder(reminder *Reminder, stopChannel chan bool)
 		if err != nil {
 			log.Errorf("error deleting reminder: %s", err)
 		}
-	}(reminder, years, months, days, period, nextTime, ttl, repetitionsLeft, stopChannel)
+	}(reminder, sched, nextTime, ttl, repetitionsLeft, stopChannel)
 
 	return nil
 }
@@ -1025,7 +1067,7 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 	reminder.RegisteredTime = dueTime.Format(time.RFC3339)
 
 	if len(req.Period) != 0 {
-		_, _, _, _, repeats, err = parseDuration(req.Period)
+		_, repeats, err = parsePeriod(req.Period)
 		if err != nil {
 			return errors.Wrap(err, "error parsing reminder period")
 		}
@@ -1058,11 +1100,10 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 
 func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest) error {
 	var (
-		err                 error
-		repeats             int
-		dueTime, ttl        time.Time
-		period              time.Duration
-		years, months, days int
+		err          error
+		repeats      int
+		dueTime, ttl time.Time
+		sched        = &schedule{}
 	)
 	a.activeTimersLock.Lock()
 	defer a.activeTimersLock.Unlock()
@@ -1074,9 +1115,9 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 		return errors.Errorf("can't create timer for actor %s: actor not activated", actorKey)
 	}
 
-	stopChan, exists := a.activeTimers.Load(timerKey)
+	active, exists := a.activeTimers.Load(timerKey)
 	if exists {
-		close(stopChan.(chan bool))
+		close(active.(*activeTimer).stop)
 	}
 
 	if len(req.DueTime) != 0 {
@@ -1092,7 +1133,7 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 
 	repeats = -1 // set to default
 	if len(req.Period) != 0 {
-		if years, months, days, period, repeats, err = parseDuration(req.Period); err != nil {
+		if sched, repeats, err = parsePeriod(req.Period); err != nil {
 			return errors.Wrap(err, "error parsing timer period")
 		}
 		// error on timers with zero repetitions
@@ -1111,9 +1152,21 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 	}
 
 	log.Debugf("create timer %q dueTime:%s period:%s repeats:%d ttl:%s",
-		req.Name, dueTime.String(), period.String(), repeats, ttl.String())
+		req.Name, dueTime.String(), req.Period, repeats, ttl.String())
 	stop := make(chan bool, 1)
-	a.activeTimers.Store(timerKey, stop)
+	a.activeTimers.Store(timerKey, &activeTimer{
+		stop: stop,
+		timer: Timer{
+			ActorID:   req.ActorID,
+			ActorType: req.ActorType,
+			Name:      req.Name,
+			Data:      req.Data,
+			Period:    req.Period,
+			DueTime:   req.DueTime,
+			TTL:       req.TTL,
+			Callback:  req.Callback,
+		},
+	})
 
 	go func(stop chan bool, req *CreateTimerRequest) {
 		var (
@@ -1125,7 +1178,7 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 			ttlTimer = time.NewTimer(time.Until(ttl))
 			ttlTimerC = ttlTimer.C
 		}
-		nextTime := dueTime
+		nextTime := sched.first(dueTime)
 		nextTimer = time.NewTimer(time.Until(nextTime))
 		defer func() {
 			if nextTimer.Stop() {
@@ -1135,8 +1188,11 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 				<-ttlTimerC
 			}
 		}()
+		if nextTime.IsZero() {
+			log.Infof("timer %s has no fire time left", timerKey)
+		}
 	L:
-		for {
+		for !nextTime.IsZero() {
 			select {
 			case <-nextTimer.C:
 				// noop
@@ -1159,13 +1215,21 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 				}
 			} else {
 				log.Errorf("could not find active timer %s", timerKey)
+				// the actor was deactivated, so the timer is dropped unless it was replaced in the meantime
+				if active, ok := a.activeTimers.Load(timerKey); ok && active.(*activeTimer).stop == stop {
+					a.activeTimers.Delete(timerKey)
+				}
 				return
 			}
-			if repeats == 0 || (years == 0 && months == 0 && days == 0 && period == 0) {
+			if repeats == 0 || !sched.repeats() {
 				log.Infof("timer %s has been completed", timerKey)
 				break L
 			}
-			nextTime = nextTime.AddDate(years, months, days).Add(period)
+			nextTime = sched.next(nextTime)
+			if nextTime.IsZero() {
+				log.Infof("timer %s has no fire time left", timerKey)
+				break L
+			}
 			if nextTimer.Stop() {
 				<-nextTimer.C
 			}
@@ -1296,11 +1360,12 @@ func (a *actorsRuntime) migrateRemindersForActorType(actorType string, actorMeta
 		return nil
 	}
 
-	if actorMetadata.RemindersMetadata.PartitionCount == a.config.RemindersStoragePartitions {
+	remindersStoragePartitions := a.config.entityConfig(actorType).RemindersStoragePartitions
+	if actorMetadata.RemindersMetadata.PartitionCount == remindersStoragePartitions {
 		return nil
 	}
 
-	if actorMetadata.RemindersMetadata.PartitionCount > a.config.RemindersStoragePartitions {
+	if actorMetadata.RemindersMetadata.PartitionCount > remindersStoragePartitions {
 		log.Warnf("cannot decrease number of partitions for reminders of actor type %s", actorType)
 		return nil
 	}
@@ -1324,7 +1389,7 @@ func (a *actorsRuntime) migrateRemindersForActorType(actorType string, actorMeta
 
 	// Recreate as a new metadata identifier.
 	actorMetadata.ID = uuid.NewString()
-	actorMetadata.RemindersMetadata.PartitionCount = a.config.RemindersStoragePartitions
+	actorMetadata.RemindersMetadata.PartitionCount = remindersStoragePartitions
 	actorRemindersPartitions := make([][]Reminder, actorMetadata.RemindersMetadata.PartitionCount)
 	for i := 0; i < actorMetadata.RemindersMetadata.PartitionCount; i++ {
 		actorRemindersPartitions[i] = make([]Reminder, 0)
@@ -1713,19 +1778,78 @@ func (a *actorsRuntime) GetReminder(ctx context.Context, req *GetReminderRequest
 	return nil, nil
 }
 
+func (a *actorsRuntime) ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error) {
+	refs, _, err := a.getRemindersForActorType(req.ActorType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders := []Reminder{}
+	for _, r := range refs {
+		if req.ActorID == "" || r.reminder.ActorID == req.ActorID {
+			reminders = append(reminders, r.reminder)
+		}
+	}
+
+	key := func(i int) string {
+		return constructCompositeKey(reminders[i].ActorID, reminders[i].Name)
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		return key(i) < key(j)
+	})
+	start, end, nextPageToken, err := paginate(len(reminders), key, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &ListRemindersResponse{
+		Reminders:     reminders[start:end],
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (a *actorsRuntime) DeleteTimer(ctx context.Context, req *DeleteTimerRequest) error {
 	actorKey := constructCompositeKey(req.ActorType, req.ActorID)
 	timerKey := constructCompositeKey(actorKey, req.Name)
 
-	stopChan, exists := a.activeTimers.Load(timerKey)
+	active, exists := a.activeTimers.Load(timerKey)
 	if exists {
-		close(stopChan.(chan bool))
+		close(active.(*activeTimer).stop)
 		a.activeTimers.Delete(timerKey)
 	}
 
 	return nil
 }
 
+func (a *actorsRuntime) ListTimers(ctx context.Context, req *ListTimersRequest) (*ListTimersResponse, error) {
+	timers := []Timer{}
+	a.activeTimers.Range(func(key, value interface{}) bool {
+		timer := value.(*activeTimer).timer
+		if timer.ActorType != req.ActorType || (req.ActorID != "" && timer.ActorID != req.ActorID) {
+			return true
+		}
+		// timers of deactivated actors don't fire anymore
+		if _, exists := a.actorsTable.Load(constructCompositeKey(timer.ActorType, timer.ActorID)); exists {
+			timers = append(timers, timer)
+		}
+		return true
+	})
+
+	key := func(i int) string {
+		return constructCompositeKey(timers[i].ActorID, timers[i].Name)
+	}
+	sort.Slice(timers, func(i, j int) bool {
+		return key(i) < key(j)
+	})
+	start, end, nextPageToken, err := paginate(len(timers), key, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &ListTimersResponse{
+		Timers:        timers[start:end],
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func (a *actorsRuntime) GetActiveActorsCount(ctx context.Context) []ActiveActorsCount {
 	actorCountMap := map[string]int{}
 	for _, actorType := range a.config.HostedActorTypes {
@@ -37,6 +37,7 @@ import (
 	"github.com/dapr/dapr/pkg/health"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 const (
@@ -233,9 +234,10 @@ func (f *fakeStateStore) Multi(request *state.TransactionalStateRequest) error {
 }
 
 type runtimeBuilder struct {
-	appChannel  channel.AppChannel
-	config      *Config
-	featureSpec []config.FeatureSpec
+	appChannel    channel.AppChannel
+	config        *Config
+	featureSpec   []config.FeatureSpec
+	pubsubAdapter runtime_pubsub.Adapter
 }
 
 func (b *runtimeBuilder) buildActorRuntime() *actorsRuntime {
@@ -255,7 +257,7 @@ func (b *runtimeBuilder) buildActorRuntime() *actorsRuntime {
 	tracingSpec := config.TracingSpec{SamplingRate: "1"}
 	store := fakeStore()
 
-	a := NewActors(store, b.appChannel, nil, *b.config, nil, tracingSpec, b.featureSpec)
+	a := NewActors(store, b.appChannel, nil, *b.config, nil, tracingSpec, b.featureSpec, b.pubsubAdapter)
 
 	return a.(*actorsRuntime)
 }
@@ -264,7 +266,7 @@ func newTestActorsRuntimeWithMock(appChannel channel.AppChannel) *actorsRuntime
 	spec := config.TracingSpec{SamplingRate: "1"}
 	store := fakeStore()
 	config := NewConfig("", TestAppID, []string{""}, nil, 0, "", "", "", false, "", config.ReentrancyConfig{}, 0)
-	a := NewActors(store, appChannel, nil, config, nil, spec, nil)
+	a := NewActors(store, appChannel, nil, config, nil, spec, nil, nil)
 
 	return a.(*actorsRuntime)
 }
@@ -279,7 +281,7 @@ func newTestActorsRuntimeWithMockAndActorMetadataPartition(appChannel channel.Ap
 			Name:    config.ActorTypeMetadata,
 			Enabled: true,
 		},
-	})
+	}, nil)
 
 	return a.(*actorsRuntime)
 }
@@ -309,7 +311,8 @@ func fakeCallAndActivateActor(actors *actorsRuntime, actorType, actorID string)
 func deactivateActorWithDuration(testActorsRuntime *actorsRuntime, actorType, actorID string, actorIdleTimeout time.Duration) {
 	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
 	scanInterval := time.Second * 1
-	testActorsRuntime.startDeactivationTicker(scanInterval, actorIdleTimeout)
+	testActorsRuntime.config.ActorIdleTimeout = actorIdleTimeout
+	testActorsRuntime.startDeactivationTicker(scanInterval)
 }
 
 func createReminderData(actorID, actorType, name, period, dueTime, ttl, data string) CreateReminderRequest {
@@ -365,6 +368,29 @@ func TestActorIsNotDeactivated(t *testing.T) {
 	assert.True(t, exists)
 }
 
+func TestActorIsDeactivatedWithEntityConfig(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	otherActorID := "other-" + actorID
+	testActorsRuntime.config.ActorIdleTimeout = time.Second * 2
+	testActorsRuntime.config.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities:         []string{"dog"},
+			ActorIdleTimeout: "5s",
+		},
+	})
+
+	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+	fakeCallAndActivateActor(testActorsRuntime, "dog", otherActorID)
+	testActorsRuntime.startDeactivationTicker(time.Second * 1)
+	time.Sleep(time.Second * 3)
+
+	_, exists := testActorsRuntime.actorsTable.Load(constructCompositeKey(actorType, actorID))
+	assert.False(t, exists)
+	_, exists = testActorsRuntime.actorsTable.Load(constructCompositeKey("dog", otherActorID))
+	assert.True(t, exists)
+}
+
 func TestStoreIsNotInited(t *testing.T) {
 	testActorsRuntime := newTestActorsRuntime()
 	testActorsRuntime.store = nil
@@ -376,7 +402,7 @@ func TestStoreIsNotInited(t *testing.T) {
 	})
 
 	t.Run("updateReminderTrack", func(t *testing.T) {
-		e := testActorsRuntime.updateReminderTrack("foo", "bar", 1, time.Now())
+		e := testActorsRuntime.updateReminderTrack("foo", "bar", 1, time.Now(), time.Time{})
 		assert.NotNil(t, e)
 	})
 
@@ -457,7 +483,7 @@ func TestSetReminderTrack(t *testing.T) {
 	testActorsRuntime := newTestActorsRuntime()
 	actorType, actorID := getTestActorTypeAndID()
 	noRepetition := -1
-	err := testActorsRuntime.updateReminderTrack(actorType, actorID, noRepetition, time.Now())
+	err := testActorsRuntime.updateReminderTrack(actorType, actorID, noRepetition, time.Now(), time.Time{})
 	assert.Nil(t, err)
 }
 
@@ -474,7 +500,7 @@ func TestGetReminderTrack(t *testing.T) {
 		actorType, actorID := getTestActorTypeAndID()
 		repetition := 10
 		now := time.Now()
-		testActorsRuntime.updateReminderTrack(actorType, actorID, repetition, now)
+		testActorsRuntime.updateReminderTrack(actorType, actorID, repetition, now, time.Time{})
 		r, _ := testActorsRuntime.getReminderTrack(actorType, actorID)
 		assert.NotEmpty(t, r.LastFiredTime)
 		assert.Equal(t, repetition, r.RepetitionLeft)
@@ -541,6 +567,38 @@ func TestCreateReminder(t *testing.T) {
 	assert.Equal(t, numReminders, len(reminders))
 }
 
+func TestRemindersPartitionsWithEntityConfig(t *testing.T) {
+	appChannel := new(mockAppChannel)
+	testActorsRuntime := newTestActorsRuntimeWithMockAndActorMetadataPartition(appChannel)
+	partitions := 2
+	testActorsRuntime.config.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities:                   []string{"dog"},
+			RemindersStoragePartitions: &partitions,
+		},
+	})
+	ctx := context.Background()
+	for _, actorType := range []string{"cat", "dog"} {
+		err := testActorsRuntime.CreateReminder(ctx, &CreateReminderRequest{
+			ActorID:   "1",
+			ActorType: actorType,
+			Name:      "reminder1",
+			Period:    "1s",
+			DueTime:   "1s",
+			Data:      nil,
+		})
+		assert.Nil(t, err)
+	}
+
+	_, actorTypeMetadata, err := testActorsRuntime.getRemindersForActorType("cat", true)
+	assert.Nil(t, err)
+	assert.Equal(t, TestActorMetadataPartitionCount, actorTypeMetadata.RemindersMetadata.PartitionCount)
+
+	_, actorTypeMetadata, err = testActorsRuntime.getRemindersForActorType("dog", true)
+	assert.Nil(t, err)
+	assert.Equal(t, partitions, actorTypeMetadata.RemindersMetadata.PartitionCount)
+}
+
 func TestRenameReminder(t *testing.T) {
 	appChannel := new(mockAppChannel)
 	testActorsRuntime := newTestActorsRuntimeWithMock(appChannel)
@@ -1006,6 +1064,139 @@ func TestGetReminder(t *testing.T) {
 	assert.Equal(t, r.DueTime, "1s")
 }
 
+func TestListReminders(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	for _, r := range []CreateReminderRequest{
+		createReminderData(actorID, actorType, "reminder2", "1h", "1h", "", "a"),
+		createReminderData(actorID, actorType, "reminder1", "1h", "1h", "", "a"),
+		createReminderData("other", actorType, "reminder1", "1h", "1h", "", "a"),
+		createReminderData(actorID, "otherType", "reminder1", "1h", "1h", "", "a"),
+	} {
+		r := r
+		assert.NoError(t, testActorsRuntime.CreateReminder(ctx, &r))
+	}
+
+	t.Run("reminders of an actor", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{
+			ActorType: actorType,
+			ActorID:   actorID,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Reminders, 2)
+		assert.Equal(t, "reminder1", resp.Reminders[0].Name)
+		assert.Equal(t, "reminder2", resp.Reminders[1].Name)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("pages of the reminders of an actor type", func(t *testing.T) {
+		names := []string{}
+		req := &ListRemindersRequest{
+			ActorType: actorType,
+			PageSize:  2,
+		}
+		for {
+			resp, err := testActorsRuntime.ListReminders(ctx, req)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(resp.Reminders), 2)
+			for _, r := range resp.Reminders {
+				names = append(names, r.ActorID+"/"+r.Name)
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		assert.Equal(t, []string{actorID + "/reminder1", actorID + "/reminder2", "other/reminder1"}, names)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		_, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{
+			ActorType: actorType,
+			PageToken: "!",
+		})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+func TestListTimers(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+	fakeCallAndActivateActor(testActorsRuntime, actorType, "other")
+
+	for _, timer := range []CreateTimerRequest{
+		createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "data"),
+		createTimerData("other", actorType, "timer1", "1h", "1h", "", "callback", "data"),
+	} {
+		timer := timer
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+	}
+
+	resp, err := testActorsRuntime.ListTimers(ctx, &ListTimersRequest{
+		ActorType: actorType,
+		ActorID:   actorID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Timer{{
+		ActorID:   actorID,
+		ActorType: actorType,
+		Name:      "timer1",
+		Data:      "data",
+		Period:    "1h",
+		DueTime:   "1h",
+		Callback:  "callback",
+	}}, resp.Timers)
+
+	resp, err = testActorsRuntime.ListTimers(ctx, &ListTimersRequest{
+		ActorType: actorType,
+		PageSize:  1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Timers, 1)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	// deleted timers are not listed
+	assert.NoError(t, testActorsRuntime.DeleteTimer(ctx, &DeleteTimerRequest{
+		Name:      "timer1",
+		ActorID:   actorID,
+		ActorType: actorType,
+	}))
+	resp, err = testActorsRuntime.ListTimers(ctx, &ListTimersRequest{
+		ActorType: actorType,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Timers, 1)
+	assert.Equal(t, "other", resp.Timers[0].ActorID)
+
+	// timers of deactivated actors are not listed
+	testActorsRuntime.actorsTable.Delete(constructCompositeKey(actorType, "other"))
+	resp, err = testActorsRuntime.ListTimers(ctx, &ListTimersRequest{
+		ActorType: actorType,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Timers)
+}
+
+func TestTimerOfDeactivatedActorIsDropped(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	actorKey := constructCompositeKey(actorType, actorID)
+	fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+	timer := createTimerData(actorID, actorType, "timer1", "100ms", "100ms", "", "callback", "")
+	assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+	testActorsRuntime.actorsTable.Delete(actorKey)
+
+	assert.Eventually(t, func() bool {
+		_, exists := testActorsRuntime.activeTimers.Load(constructCompositeKey(actorKey, "timer1"))
+		return !exists
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestDeleteTimer(t *testing.T) {
 	testActorsRuntime := newTestActorsRuntime()
 	actorType, actorID := getTestActorTypeAndID()
@@ -1332,6 +1523,43 @@ func TestReminderDueDate(t *testing.T) {
 	assert.NotEmpty(t, track.LastFiredTime)
 }
 
+// blockingAppChannel holds every invocation until it is released
+type blockingAppChannel struct {
+	channel.AppChannel
+	invoked chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingAppChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	b.invoked <- struct{}{}
+	<-b.release
+	return invokev1.NewInvokeMethodResponse(200, "OK", nil), nil
+}
+
+func TestReminderTrackIsNotWrittenAfterDelete(t *testing.T) {
+	appChannel := &blockingAppChannel{invoked: make(chan struct{}), release: make(chan struct{})}
+	testActorsRuntime := newTestActorsRuntimeWithMock(appChannel)
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	actorKey := constructCompositeKey(actorType, actorID)
+	reminder := createReminderData(actorID, actorType, "reminder1", "1s", "100ms", "", "a")
+	require.NoError(t, testActorsRuntime.CreateReminder(ctx, &reminder))
+
+	// the reminder is deleted while it is delivered
+	<-appChannel.invoked
+	require.NoError(t, testActorsRuntime.DeleteReminder(ctx, &DeleteReminderRequest{
+		Name:      "reminder1",
+		ActorID:   actorID,
+		ActorType: actorType,
+	}))
+	close(appChannel.release)
+
+	time.Sleep(time.Millisecond * 200)
+	track, err := testActorsRuntime.getReminderTrack(actorKey, "reminder1")
+	require.NoError(t, err)
+	assert.Empty(t, track.LastFiredTime)
+}
+
 func TestReminderPeriod(t *testing.T) {
 	testActorsRuntime := newTestActorsRuntime()
 	actorType, actorID := getTestActorTypeAndID()
@@ -1742,6 +1970,61 @@ func TestReentrancyConfig(t *testing.T) {
 	})
 }
 
+func TestEntitiesConfig(t *testing.T) {
+	reentrancyLimit := 64
+	partitions := 7
+	drainRebalancedActors := false
+	c := NewConfig("localhost:5050", "app1", []string{"placement:5050"}, []string{"cat", "dog", "bird"}, 3500, "1s", "2s", "3s", true, "default",
+		config.ReentrancyConfig{}, 3)
+	c.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities:                   []string{"dog", "bird"},
+			ActorIdleTimeout:           "10s",
+			DrainOngoingCallTimeout:    "invalid",
+			DrainRebalancedActors:      &drainRebalancedActors,
+			Reentrancy:                 &config.ReentrancyConfig{Enabled: true},
+			RemindersStoragePartitions: &partitions,
+		},
+		{
+			Entities:                []string{"fish"},
+			DrainOngoingCallTimeout: "20s",
+			Reentrancy:              &config.ReentrancyConfig{Enabled: true, MaxStackDepth: &reentrancyLimit},
+		},
+	})
+
+	t.Run("actor type without entity config", func(t *testing.T) {
+		entityConfig := c.entityConfig("cat")
+		assert.Equal(t, "2s", entityConfig.ActorIdleTimeout.String())
+		assert.Equal(t, "3s", entityConfig.DrainOngoingCallTimeout.String())
+		assert.True(t, entityConfig.DrainRebalancedActors)
+		assert.False(t, entityConfig.Reentrancy.Enabled)
+		assert.Equal(t, 32, *entityConfig.Reentrancy.MaxStackDepth)
+		assert.Equal(t, 3, entityConfig.RemindersStoragePartitions)
+	})
+
+	t.Run("overridden settings", func(t *testing.T) {
+		for _, actorType := range []string{"dog", "bird"} {
+			entityConfig := c.entityConfig(actorType)
+			assert.Equal(t, "10s", entityConfig.ActorIdleTimeout.String())
+			assert.Equal(t, "3s", entityConfig.DrainOngoingCallTimeout.String())
+			assert.False(t, entityConfig.DrainRebalancedActors)
+			assert.True(t, entityConfig.Reentrancy.Enabled)
+			assert.Equal(t, 32, *entityConfig.Reentrancy.MaxStackDepth)
+			assert.Equal(t, 7, entityConfig.RemindersStoragePartitions)
+		}
+	})
+
+	t.Run("settings not set keep the global values", func(t *testing.T) {
+		entityConfig := c.entityConfig("fish")
+		assert.Equal(t, "2s", entityConfig.ActorIdleTimeout.String())
+		assert.Equal(t, "20s", entityConfig.DrainOngoingCallTimeout.String())
+		assert.True(t, entityConfig.DrainRebalancedActors)
+		assert.True(t, entityConfig.Reentrancy.Enabled)
+		assert.Equal(t, 64, *entityConfig.Reentrancy.MaxStackDepth)
+		assert.Equal(t, 3, entityConfig.RemindersStoragePartitions)
+	})
+}
+
 func TestHostValidation(t *testing.T) {
 	t.Run("kubernetes mode with mTLS, missing namespace", func(t *testing.T) {
 		err := ValidateHostEnvironment(true, modes.KubernetesMode, "")
@@ -1937,3 +2220,33 @@ func TestReentrancyStackLimit(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Error(t, err)
 }
+
+func TestReentrancyWithEntityConfig(t *testing.T) {
+	stackDepth := 0
+	reentrantConfig := NewConfig("", TestAppID, []string{""}, nil, 0, "", "", "", false, "", config.ReentrancyConfig{}, 0)
+	reentrantConfig.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities:   []string{"reentrant"},
+			Reentrancy: &config.ReentrancyConfig{Enabled: true, MaxStackDepth: &stackDepth},
+		},
+	})
+	reentrantAppChannel := new(reentrantAppChannel)
+	reentrantAppChannel.nextCall = []*invokev1.InvokeMethodRequest{}
+	reentrantAppChannel.callLog = []string{}
+	builder := runtimeBuilder{
+		appChannel:  reentrantAppChannel,
+		config:      &reentrantConfig,
+		featureSpec: []config.FeatureSpec{{Name: "Actor.Reentrancy", Enabled: true}},
+	}
+	testActorRuntime := builder.buildActorRuntime()
+	reentrantAppChannel.a = testActorRuntime
+
+	// the stack limit of the entity config applies to the reentrant actor type only
+	resp, err := testActorRuntime.callLocalActor(context.Background(), invokev1.NewInvokeMethodRequest("first").WithActor("reentrant", "1"))
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+
+	resp, err = testActorRuntime.callLocalActor(context.Background(), invokev1.NewInvokeMethodRequest("first").WithActor("other", "1"))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
@@ -34,6 +34,21 @@ type Config struct {
 	Namespace                     string
 	Reentrancy                    app_config.ReentrancyConfig
 	RemindersStoragePartitions    int
+	// ReminderFailurePolicies are the reminder failure policies by actor type
+	ReminderFailurePolicies map[string]ReminderFailurePolicy
+	// EntitiesConfig are the settings overridden by actor type
+	EntitiesConfig map[string]EntityConfig
+}
+
+// EntityConfig is the actor runtime configuration of an actor type.
+type EntityConfig struct {
+	ActorIdleTimeout           time.Duration
+	DrainOngoingCallTimeout    time.Duration
+	DrainRebalancedActors      bool
+	Reentrancy                 app_config.ReentrancyConfig
+	RemindersStoragePartitions int
+	// ReminderFailurePolicy is applied when the app fails to handle a reminder. The zero policy delivers the reminder once
+	ReminderFailurePolicy ReminderFailurePolicy
 }
 
 const (
@@ -86,3 +101,69 @@ func NewConfig(hostAddress, appID string, placementAddresses []string, hostedAct
 
 	return c
 }
+
+// SetEntitiesConfig overrides the settings of the configuration for the actor types of the entities config.
+// The settings that are not set, or cannot be parsed, keep the values of the configuration.
+func (c *Config) SetEntitiesConfig(entitiesConfig []app_config.EntityConfig) {
+	c.EntitiesConfig = map[string]EntityConfig{}
+	for _, config := range entitiesConfig {
+		entityConfig := c.defaultEntityConfig()
+
+		idleDuration, err := time.ParseDuration(config.ActorIdleTimeout)
+		if err == nil {
+			entityConfig.ActorIdleTimeout = idleDuration
+		}
+
+		drainCallDuration, err := time.ParseDuration(config.DrainOngoingCallTimeout)
+		if err == nil {
+			entityConfig.DrainOngoingCallTimeout = drainCallDuration
+		}
+
+		if config.DrainRebalancedActors != nil {
+			entityConfig.DrainRebalancedActors = *config.DrainRebalancedActors
+		}
+
+		if config.Reentrancy != nil {
+			entityConfig.Reentrancy = *config.Reentrancy
+			if entityConfig.Reentrancy.MaxStackDepth == nil {
+				reentrancyLimit := defaultReentrancyStackLimit
+				entityConfig.Reentrancy.MaxStackDepth = &reentrancyLimit
+			}
+		}
+
+		if config.RemindersStoragePartitions != nil {
+			entityConfig.RemindersStoragePartitions = *config.RemindersStoragePartitions
+		}
+
+		if config.ReminderFailurePolicy != nil {
+			entityConfig.ReminderFailurePolicy = newReminderFailurePolicy(*config.ReminderFailurePolicy, config.Entities)
+		}
+
+		for _, actorType := range config.Entities {
+			c.EntitiesConfig[actorType] = entityConfig
+		}
+	}
+}
+
+// entityConfig returns the configuration of the actor type.
+// The reminder failure policy of the actor type applies when the entities config does not set one.
+func (c *Config) entityConfig(actorType string) EntityConfig {
+	entityConfig, ok := c.EntitiesConfig[actorType]
+	if !ok {
+		entityConfig = c.defaultEntityConfig()
+	}
+	if entityConfig.ReminderFailurePolicy == (ReminderFailurePolicy{}) {
+		entityConfig.ReminderFailurePolicy = c.ReminderFailurePolicies[actorType]
+	}
+	return entityConfig
+}
+
+func (c *Config) defaultEntityConfig() EntityConfig {
+	return EntityConfig{
+		ActorIdleTimeout:           c.ActorIdleTimeout,
+		DrainOngoingCallTimeout:    c.DrainOngoingCallTimeout,
+		DrainRebalancedActors:      c.DrainRebalancedActors,
+		Reentrancy:                 c.Reentrancy,
+		RemindersStoragePartitions: c.RemindersStoragePartitions,
+	}
+}
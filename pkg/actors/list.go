@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultListPageSize is the page size of the reminders and timers lists when none is requested.
+	DefaultListPageSize = 100
+	// MaxListPageSize bounds the page size of the reminders and timers lists.
+	MaxListPageSize = 1000
+)
+
+// ErrInvalidPageToken is returned when a page token was not returned by a previous list call.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// paginate returns the bounds of the page of n items sorted by key, and the token of the next page.
+// The token holds the key of the last item of the page, so the pages stay consistent when items are added or removed between calls.
+func paginate(n int, key func(i int) string, pageSize int, pageToken string) (int, int, string, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	} else if pageSize > MaxListPageSize {
+		pageSize = MaxListPageSize
+	}
+
+	start := 0
+	if pageToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || len(b) == 0 {
+			return 0, 0, "", ErrInvalidPageToken
+		}
+		after := string(b)
+		start = sort.Search(n, func(i int) bool { return key(i) > after })
+	}
+
+	end := start + pageSize
+	if end >= n {
+		return start, n, "", nil
+	}
+	return start, end, base64.RawURLEncoding.EncodeToString([]byte(key(end - 1))), nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+// ListRemindersRequest is the request object to list the reminders of an actor type, or of a single actor when the actor ID is set.
+type ListRemindersRequest struct {
+	ActorType string
+	ActorID   string
+	// PageSize is the maximum number of reminders returned, DefaultListPageSize when zero
+	PageSize int
+	// PageToken is the NextPageToken of the previous page, empty for the first page
+	PageToken string
+}
+
+// ListRemindersResponse is a page of reminders sorted by actor ID and name.
+type ListRemindersResponse struct {
+	Reminders []Reminder `json:"reminders"`
+	// NextPageToken is empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
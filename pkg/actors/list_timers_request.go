@@ -0,0 +1,32 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+// ListTimersRequest is the request object to list the active timers of an actor type, or of a single actor when the actor ID is set.
+// Timers are not persisted, so only the timers of the actors activated on this host are listed.
+type ListTimersRequest struct {
+	ActorType string
+	ActorID   string
+	// PageSize is the maximum number of timers returned, DefaultListPageSize when zero
+	PageSize int
+	// PageToken is the NextPageToken of the previous page, empty for the first page
+	PageToken string
+}
+
+// ListTimersResponse is a page of timers sorted by actor ID and name.
+type ListTimersResponse struct {
+	Timers []Timer `json:"timers"`
+	// NextPageToken is empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/pkg/errors"
+
+	"github.com/dapr/components-contrib/contenttype"
+	"github.com/dapr/components-contrib/pubsub"
+
+	app_config "github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	defaultReminderBackoff    = time.Second
+	defaultReminderMaxBackoff = time.Minute
+)
+
+// ReminderFailurePolicy is the policy applied when the app fails to handle a reminder.
+// The failed delivery is retried with an exponential backoff, and published to the dead-letter topic when configured after the last retry.
+// The retries delay the next fire time of the reminder.
+type ReminderFailurePolicy struct {
+	MaxRetries       int
+	Backoff          time.Duration
+	MaxBackoff       time.Duration
+	DeadLetterPubsub string
+	DeadLetterTopic  string
+}
+
+// NewReminderFailurePolicies returns the reminder failure policies of the application config by actor type.
+func NewReminderFailurePolicies(configs []app_config.ReminderFailurePolicyConfig) map[string]ReminderFailurePolicy {
+	policies := map[string]ReminderFailurePolicy{}
+	for _, c := range configs {
+		policy := newReminderFailurePolicy(c, c.Entities)
+		for _, actorType := range c.Entities {
+			policies[actorType] = policy
+		}
+	}
+	return policies
+}
+
+// newReminderFailurePolicy returns the reminder failure policy of the actor types.
+func newReminderFailurePolicy(c app_config.ReminderFailurePolicyConfig, actorTypes []string) ReminderFailurePolicy {
+	return ReminderFailurePolicy{
+		MaxRetries:       c.MaxRetries,
+		Backoff:          parseReminderBackoff("backoff", c.Backoff, actorTypes, defaultReminderBackoff),
+		MaxBackoff:       parseReminderBackoff("maxBackoff", c.MaxBackoff, actorTypes, defaultReminderMaxBackoff),
+		DeadLetterPubsub: c.DeadLetterPubsub,
+		DeadLetterTopic:  c.DeadLetterTopic,
+	}
+}
+
+// parseReminderBackoff returns the duration of a backoff setting, or the default when the setting is empty or invalid.
+func parseReminderBackoff(setting, value string, actorTypes []string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Warnf("invalid %s %q in the reminder failure policy of actor types %v, using %s: %s", setting, value, actorTypes, defaultValue, err)
+		return defaultValue
+	}
+	return d
+}
+
+func (p *ReminderFailurePolicy) deadLetterEnabled() bool {
+	return p.DeadLetterPubsub != "" && p.DeadLetterTopic != ""
+}
+
+// DeadLetterReminder is the data of the cloud event published to the dead-letter topic for a reminder that the app failed to handle.
+type DeadLetterReminder struct {
+	ActorID   string      `json:"actorID"`
+	ActorType string      `json:"actorType"`
+	Name      string      `json:"name"`
+	Data      interface{} `json:"data"`
+	DueTime   string      `json:"dueTime"`
+	Period    string      `json:"period"`
+	FireTime  string      `json:"fireTime"`
+	Attempts  int         `json:"attempts"`
+	Error     string      `json:"error"`
+}
+
+// deliverReminder executes the reminder with the failure policy of its actor type.
+// It returns false if the reminder is stopped while the delivery is backing off.
+func (a *actorsRuntime) deliverReminder(reminder *Reminder, fireTime time.Time, stop chan bool) bool {
+	policy := a.config.entityConfig(reminder.ActorType).ReminderFailurePolicy
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = policy.Backoff
+	b.MaxInterval = policy.MaxBackoff
+	b.MaxElapsedTime = 0
+
+	attempts := 0
+	for {
+		attempts++
+		err := a.executeReminder(reminder)
+		if err == nil {
+			return true
+		}
+		log.Errorf("error execution of reminder %q for actor type %s with id %s (attempt %d): %v",
+			reminder.Name, reminder.ActorType, reminder.ActorID, attempts, err)
+		if attempts > policy.MaxRetries {
+			diag.DefaultMonitoring.ActorReminderFailed(reminder.ActorType)
+			if policy.deadLetterEnabled() {
+				a.deadLetterReminder(reminder, &policy, fireTime, attempts, err)
+			}
+			return true
+		}
+
+		retryTimer := time.NewTimer(b.NextBackOff())
+		select {
+		case <-retryTimer.C:
+			diag.DefaultMonitoring.ActorReminderRetried(reminder.ActorType)
+		case <-stop:
+			retryTimer.Stop()
+			return false
+		}
+	}
+}
+
+// deadLetterReminder publishes the failed reminder to the dead-letter topic of the policy.
+func (a *actorsRuntime) deadLetterReminder(reminder *Reminder, policy *ReminderFailurePolicy, fireTime time.Time, attempts int, cause error) {
+	err := a.publishDeadLetterReminder(reminder, policy, fireTime, attempts, cause)
+	if err != nil {
+		log.Errorf("error publishing reminder %q for actor type %s with id %s to dead-letter topic %s of pubsub %s: %v",
+			reminder.Name, reminder.ActorType, reminder.ActorID, policy.DeadLetterTopic, policy.DeadLetterPubsub, err)
+		diag.DefaultMonitoring.ActorReminderDeadLetterFailed(reminder.ActorType, "publish")
+		return
+	}
+	diag.DefaultMonitoring.ActorReminderDeadLettered(reminder.ActorType)
+}
+
+func (a *actorsRuntime) publishDeadLetterReminder(reminder *Reminder, policy *ReminderFailurePolicy, fireTime time.Time, attempts int, cause error) error {
+	if a.pubsubAdapter == nil {
+		return errors.New("no pubsub is configured")
+	}
+	data, err := json.Marshal(&DeadLetterReminder{
+		ActorID:   reminder.ActorID,
+		ActorType: reminder.ActorType,
+		Name:      reminder.Name,
+		Data:      reminder.Data,
+		DueTime:   reminder.DueTime,
+		Period:    reminder.Period,
+		FireTime:  fireTime.UTC().Format(time.RFC3339),
+		Attempts:  attempts,
+		Error:     cause.Error(),
+	})
+	if err != nil {
+		return err
+	}
+	envelope, err := runtime_pubsub.NewCloudEvent(&runtime_pubsub.CloudEvent{
+		ID:              a.config.AppID,
+		Topic:           policy.DeadLetterTopic,
+		DataContentType: contenttype.JSONContentType,
+		Data:            data,
+		Pubsub:          policy.DeadLetterPubsub,
+	})
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	return a.pubsubAdapter.Publish(context.Background(), &pubsub.PublishRequest{
+		PubsubName: policy.DeadLetterPubsub,
+		Topic:      policy.DeadLetterTopic,
+		Data:       b,
+	})
+}
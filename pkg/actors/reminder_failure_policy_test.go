@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/pubsub"
+
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+)
+
+// failingAppChannel fails the first calls to the app.
+type failingAppChannel struct {
+	channel.AppChannel
+	lock     sync.Mutex
+	failures int
+	calls    int
+}
+
+func (f *failingAppChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errors.New("app unavailable")
+	}
+	return invokev1.NewInvokeMethodResponse(200, "OK", nil), nil
+}
+
+func (f *failingAppChannel) callCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.calls
+}
+
+type fakePubsubAdapter struct {
+	lock      sync.Mutex
+	published []*pubsub.PublishRequest
+}
+
+func (f *fakePubsubAdapter) GetPubSub(pubsubName string) pubsub.PubSub {
+	return nil
+}
+
+func (f *fakePubsubAdapter) Publish(ctx context.Context, req *pubsub.PublishRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.published = append(f.published, req)
+	return nil
+}
+
+func (f *fakePubsubAdapter) requests() []*pubsub.PublishRequest {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]*pubsub.PublishRequest{}, f.published...)
+}
+
+func newTestActorsRuntimeWithFailurePolicy(appChannel channel.AppChannel, adapter *fakePubsubAdapter, entities ...config.EntityConfig) *actorsRuntime {
+	c := NewConfig("", TestAppID, []string{""}, nil, 0, "", "", "", false, "", config.ReentrancyConfig{}, 0)
+	c.SetEntitiesConfig(entities)
+	builder := runtimeBuilder{
+		appChannel:    appChannel,
+		config:        &c,
+		pubsubAdapter: adapter,
+	}
+	return builder.buildActorRuntime()
+}
+
+func TestEntitiesConfigReminderFailurePolicy(t *testing.T) {
+	c := NewConfig("", TestAppID, []string{""}, nil, 0, "", "", "", false, "", config.ReentrancyConfig{}, 0)
+	c.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities: []string{"billing", "invoice"},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				MaxRetries:       3,
+				Backoff:          "2s",
+				DeadLetterPubsub: "pubsub",
+				DeadLetterTopic:  "reminders-dlq",
+			},
+		},
+		{
+			Entities: []string{"cart"},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				Backoff:    "invalid",
+				MaxBackoff: "10s",
+			},
+		},
+		{
+			Entities: []string{"order"},
+		},
+	})
+
+	assert.Equal(t, ReminderFailurePolicy{
+		MaxRetries:       3,
+		Backoff:          2 * time.Second,
+		MaxBackoff:       defaultReminderMaxBackoff,
+		DeadLetterPubsub: "pubsub",
+		DeadLetterTopic:  "reminders-dlq",
+	}, c.entityConfig("invoice").ReminderFailurePolicy)
+	assert.Equal(t, c.entityConfig("billing").ReminderFailurePolicy, c.entityConfig("invoice").ReminderFailurePolicy)
+	assert.Equal(t, ReminderFailurePolicy{
+		Backoff:    defaultReminderBackoff,
+		MaxBackoff: 10 * time.Second,
+	}, c.entityConfig("cart").ReminderFailurePolicy)
+	assert.Equal(t, ReminderFailurePolicy{}, c.entityConfig("order").ReminderFailurePolicy)
+	assert.Equal(t, ReminderFailurePolicy{}, c.entityConfig("other").ReminderFailurePolicy)
+}
+
+func TestNewReminderFailurePolicies(t *testing.T) {
+	c := NewConfig("", TestAppID, []string{""}, nil, 0, "", "", "", false, "", config.ReentrancyConfig{}, 0)
+	c.ReminderFailurePolicies = NewReminderFailurePolicies([]config.ReminderFailurePolicyConfig{
+		{
+			Entities:   []string{"billing", "cart"},
+			MaxRetries: 2,
+			Backoff:    "invalid",
+			MaxBackoff: "10s",
+		},
+	})
+	c.SetEntitiesConfig([]config.EntityConfig{
+		{
+			Entities: []string{"cart"},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				MaxRetries: 5,
+			},
+		},
+		{
+			Entities: []string{"billing"},
+		},
+	})
+
+	assert.Equal(t, ReminderFailurePolicy{
+		MaxRetries: 2,
+		Backoff:    defaultReminderBackoff,
+		MaxBackoff: 10 * time.Second,
+	}, c.entityConfig("billing").ReminderFailurePolicy)
+	assert.Equal(t, ReminderFailurePolicy{
+		MaxRetries: 5,
+		Backoff:    defaultReminderBackoff,
+		MaxBackoff: defaultReminderMaxBackoff,
+	}, c.entityConfig("cart").ReminderFailurePolicy)
+	assert.Equal(t, ReminderFailurePolicy{}, c.entityConfig("other").ReminderFailurePolicy)
+}
+
+func TestReminderFailurePolicy(t *testing.T) {
+	actorType, actorID := getTestActorTypeAndID()
+	reminder := &Reminder{
+		ActorType: actorType,
+		ActorID:   actorID,
+		Name:      "reminder1",
+		Data:      "data",
+		Period:    "1h",
+	}
+	fireTime := time.Date(2021, 12, 6, 9, 0, 0, 0, time.UTC)
+
+	t.Run("no policy delivers once", func(t *testing.T) {
+		appChannel := &failingAppChannel{failures: 1}
+		adapter := &fakePubsubAdapter{}
+		testActorsRuntime := newTestActorsRuntimeWithFailurePolicy(appChannel, adapter)
+
+		assert.True(t, testActorsRuntime.deliverReminder(reminder, fireTime, make(chan bool)))
+		assert.Equal(t, 1, appChannel.callCount())
+		assert.Empty(t, adapter.requests())
+	})
+
+	t.Run("retries until the delivery succeeds", func(t *testing.T) {
+		appChannel := &failingAppChannel{failures: 2}
+		adapter := &fakePubsubAdapter{}
+		testActorsRuntime := newTestActorsRuntimeWithFailurePolicy(appChannel, adapter, config.EntityConfig{
+			Entities: []string{actorType},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				MaxRetries:       3,
+				Backoff:          "1ms",
+				DeadLetterPubsub: "pubsub",
+				DeadLetterTopic:  "reminders-dlq",
+			},
+		})
+
+		assert.True(t, testActorsRuntime.deliverReminder(reminder, fireTime, make(chan bool)))
+		assert.Equal(t, 3, appChannel.callCount())
+		assert.Empty(t, adapter.requests())
+	})
+
+	t.Run("publishes to the dead-letter topic after the last retry", func(t *testing.T) {
+		appChannel := &failingAppChannel{failures: 10}
+		adapter := &fakePubsubAdapter{}
+		testActorsRuntime := newTestActorsRuntimeWithFailurePolicy(appChannel, adapter, config.EntityConfig{
+			Entities: []string{actorType},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				MaxRetries:       2,
+				Backoff:          "1ms",
+				DeadLetterPubsub: "pubsub",
+				DeadLetterTopic:  "reminders-dlq",
+			},
+		})
+
+		assert.True(t, testActorsRuntime.deliverReminder(reminder, fireTime, make(chan bool)))
+		assert.Equal(t, 3, appChannel.callCount())
+		requests := adapter.requests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, "pubsub", requests[0].PubsubName)
+		assert.Equal(t, "reminders-dlq", requests[0].Topic)
+
+		var envelope struct {
+			Source string             `json:"source"`
+			Data   DeadLetterReminder `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(requests[0].Data, &envelope))
+		assert.Equal(t, TestAppID, envelope.Source)
+		assert.Equal(t, DeadLetterReminder{
+			ActorID:   actorID,
+			ActorType: actorType,
+			Name:      "reminder1",
+			Data:      "data",
+			Period:    "1h",
+			FireTime:  "2021-12-06T09:00:00Z",
+			Attempts:  3,
+			Error:     "app unavailable",
+		}, envelope.Data)
+	})
+
+	t.Run("stops retrying when the reminder is deleted", func(t *testing.T) {
+		appChannel := &failingAppChannel{failures: 10}
+		adapter := &fakePubsubAdapter{}
+		testActorsRuntime := newTestActorsRuntimeWithFailurePolicy(appChannel, adapter, config.EntityConfig{
+			Entities: []string{actorType},
+			ReminderFailurePolicy: &config.ReminderFailurePolicyConfig{
+				MaxRetries:       3,
+				Backoff:          "1h",
+				DeadLetterPubsub: "pubsub",
+				DeadLetterTopic:  "reminders-dlq",
+			},
+		})
+
+		stop := make(chan bool)
+		close(stop)
+		assert.False(t, testActorsRuntime.deliverReminder(reminder, fireTime, stop))
+		assert.Equal(t, 1, appChannel.callCount())
+		assert.Empty(t, adapter.requests())
+	})
+}
@@ -17,4 +17,6 @@ package actors
 type ReminderTrack struct {
 	LastFiredTime  string `json:"lastFiredTime"`
 	RepetitionLeft int    `json:"repetitionLeft"`
+	// NextFireTime is the next time the reminder fires, so a reminder resumed on another host or after a restart keeps its schedule
+	NextFireTime string `json:"nextFireTime,omitempty"`
 }
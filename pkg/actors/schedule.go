@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	// the time zones of cron expressions are resolved even in images without a time zone database
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser parses the standard five fields cron expressions and the @hourly, @daily, @every <duration>... descriptors.
+// The expression is evaluated in the time zone of an optional CRON_TZ=<zone> or TZ=<zone> prefix, in UTC otherwise.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+var cronRepetitionPattern = regexp.MustCompile(`^R(\d+)/(.+)$`)
+
+// schedule computes the fire times of a reminder or timer period.
+type schedule struct {
+	years, months, days int
+	period              time.Duration
+	// cron is set when the period is a cron expression
+	cron cron.Schedule
+}
+
+// first returns the first fire time at or after the due time.
+// It returns the zero time if the cron schedule has no fire time left.
+func (s *schedule) first(dueTime time.Time) time.Time {
+	if s.cron != nil {
+		return s.cron.Next(dueTime.Add(-time.Nanosecond))
+	}
+	return dueTime
+}
+
+// next returns the fire time following the given fire time.
+// It returns the zero time if the cron schedule has no fire time left, which finishes the reminder or timer.
+func (s *schedule) next(fireTime time.Time) time.Time {
+	if s.cron != nil {
+		return s.cron.Next(fireTime)
+	}
+	return fireTime.AddDate(s.years, s.months, s.days).Add(s.period)
+}
+
+// repeats returns false if the schedule fires only once.
+func (s *schedule) repeats() bool {
+	return s.cron != nil || s.years != 0 || s.months != 0 || s.days != 0 || s.period != 0
+}
+
+// parsePeriod creates the schedule of a period in either:
+// - ISO8601 duration format,
+// - time.Duration string format,
+// - cron format with an optional time zone, e.g. CRON_TZ=Europe/Berlin 0 9 * * 1-5.
+// A cron expression may be prefixed with a number of repetitions, e.g. R5/@daily.
+func parsePeriod(from string) (*schedule, int, error) {
+	y, m, d, dur, r, err := parseDuration(from)
+	if err == nil {
+		return &schedule{years: y, months: m, days: d, period: dur}, r, nil
+	}
+	if !isCronExpression(from) {
+		return nil, 0, err
+	}
+
+	expression, repetition := from, -1
+	if match := cronRepetitionPattern.FindStringSubmatch(from); match != nil {
+		if repetition, err = strconv.Atoi(match[1]); err != nil {
+			return nil, 0, err
+		}
+		expression = match[2]
+	}
+	sched, err := cronParser.Parse(expression)
+	if err != nil {
+		return nil, 0, errors.Errorf("unsupported cron format %q: %s", from, err)
+	}
+	if sched.Next(time.Now()).IsZero() {
+		return nil, 0, errors.Errorf("cron expression %q never fires", from)
+	}
+	return &schedule{cron: sched}, repetition, nil
+}
+
+// isCronExpression returns true if the period looks like a cron expression rather than a duration.
+func isCronExpression(from string) bool {
+	if match := cronRepetitionPattern.FindStringSubmatch(from); match != nil {
+		from = match[2]
+	}
+	return strings.HasPrefix(from, "@") || strings.HasPrefix(from, "CRON_TZ=") || strings.HasPrefix(from, "TZ=") || strings.Contains(from, " ")
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeriod(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	t.Run("parse time.Duration", func(t *testing.T) {
+		sched, repetition, err := parsePeriod("0h30m0s")
+		require.NoError(t, err)
+		assert.Equal(t, -1, repetition)
+		start := time.Date(2021, 12, 6, 17, 0, 0, 0, time.UTC)
+		assert.Equal(t, start, sched.first(start))
+		assert.Equal(t, start.Add(30*time.Minute), sched.next(start))
+		assert.True(t, sched.repeats())
+	})
+	t.Run("parse ISO 8601 duration with repetition", func(t *testing.T) {
+		sched, repetition, err := parsePeriod("R5/P1M")
+		require.NoError(t, err)
+		assert.Equal(t, 5, repetition)
+		start := time.Date(2021, 12, 6, 17, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2022, 1, 6, 17, 0, 0, 0, time.UTC), sched.next(start))
+	})
+	t.Run("parse zero duration", func(t *testing.T) {
+		sched, _, err := parsePeriod("0s")
+		require.NoError(t, err)
+		assert.False(t, sched.repeats())
+	})
+	t.Run("parse cron with time zone", func(t *testing.T) {
+		// every weekday at 09:00 in Berlin
+		sched, repetition, err := parsePeriod("CRON_TZ=Europe/Berlin 0 9 * * 1-5")
+		require.NoError(t, err)
+		assert.Equal(t, -1, repetition)
+		assert.True(t, sched.repeats())
+
+		// Friday 2021-10-29 09:00 is followed by Monday 2021-11-01 09:00, after the switch to winter time
+		friday := time.Date(2021, 10, 29, 9, 0, 0, 0, berlin)
+		monday := sched.next(friday)
+		assert.True(t, time.Date(2021, 11, 1, 9, 0, 0, 0, berlin).Equal(monday))
+		assert.Equal(t, 8, monday.UTC().Hour())
+		assert.Equal(t, 7, friday.UTC().Hour())
+	})
+	t.Run("first fire time of a cron is at or after the due time", func(t *testing.T) {
+		sched, _, err := parsePeriod("TZ=Europe/Berlin 0 9 * * *")
+		require.NoError(t, err)
+		due := time.Date(2021, 12, 6, 9, 0, 0, 0, berlin)
+		assert.True(t, due.Equal(sched.first(due)))
+		assert.True(t, due.AddDate(0, 0, 1).Equal(sched.first(due.Add(time.Millisecond))))
+	})
+	t.Run("parse cron without time zone", func(t *testing.T) {
+		sched, _, err := parsePeriod("30 * * * *")
+		require.NoError(t, err)
+		start := time.Date(2021, 12, 6, 17, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2021, 12, 6, 17, 30, 0, 0, time.UTC), sched.next(start))
+	})
+	t.Run("parse cron descriptor with repetition", func(t *testing.T) {
+		sched, repetition, err := parsePeriod("R3/@every 1s")
+		require.NoError(t, err)
+		assert.Equal(t, 3, repetition)
+		start := time.Date(2021, 12, 6, 17, 0, 0, 0, time.UTC)
+		assert.Equal(t, start.Add(time.Second), sched.next(start))
+	})
+	t.Run("parse invalid cron", func(t *testing.T) {
+		_, _, err := parsePeriod("0 25 * * *")
+		assert.Error(t, err)
+		_, _, err = parsePeriod("CRON_TZ=Mars/Olympus 0 9 * * *")
+		assert.Error(t, err)
+	})
+	t.Run("parse cron that never fires", func(t *testing.T) {
+		_, _, err := parsePeriod("0 0 30 2 *")
+		assert.EqualError(t, err, "cron expression \"0 0 30 2 *\" never fires")
+	})
+	t.Run("cron without fire time left", func(t *testing.T) {
+		// 2100 is not a leap year, and the search for the next fire time stops after five years
+		sched, _, err := parsePeriod("0 0 29 2 *")
+		require.NoError(t, err)
+		assert.True(t, sched.first(time.Date(2096, 3, 1, 0, 0, 0, 0, time.UTC)).IsZero())
+		assert.True(t, sched.next(time.Date(2096, 2, 29, 0, 0, 0, 0, time.UTC)).IsZero())
+	})
+	t.Run("parse invalid period", func(t *testing.T) {
+		_, _, err := parsePeriod("invalid")
+		assert.EqualError(t, err, "unsupported duration format \"invalid\"")
+	})
+}
+
+func TestCronReminderTracksNextFireTime(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	actorKey := constructCompositeKey(actorType, actorID)
+	reminder := createReminderData(actorID, actorType, "reminder1", "@every 1s", "", "", "a")
+	require.NoError(t, testActorsRuntime.CreateReminder(context.Background(), &reminder))
+
+	time.Sleep(time.Millisecond * 1500)
+
+	track, err := testActorsRuntime.getReminderTrack(actorKey, "reminder1")
+	require.NoError(t, err)
+	require.NotEmpty(t, track.LastFiredTime)
+	lastFiredTime, err := time.Parse(time.RFC3339, track.LastFiredTime)
+	require.NoError(t, err)
+	nextFireTime, err := time.Parse(time.RFC3339, track.NextFireTime)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, nextFireTime.Sub(lastFiredTime))
+}
+
+func TestReminderResumesFromNextFireTime(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	actorKey := constructCompositeKey(actorType, actorID)
+	// the reminder last fired a day ago and persisted its next fire time in the past, so it fires on resume
+	lastFiredTime := time.Now().Add(-24 * time.Hour)
+	require.NoError(t, testActorsRuntime.updateReminderTrack(actorKey, "reminder1", -1, lastFiredTime, time.Now().Add(-time.Minute)))
+
+	reminder := createReminderData(actorID, actorType, "reminder1", "CRON_TZ=Europe/Berlin 0 9 * * 1-5", "", "", "a")
+	require.NoError(t, testActorsRuntime.CreateReminder(context.Background(), &reminder))
+
+	time.Sleep(time.Millisecond * 500)
+
+	track, err := testActorsRuntime.getReminderTrack(actorKey, "reminder1")
+	require.NoError(t, err)
+	assert.NotEqual(t, lastFiredTime.Format(time.RFC3339), track.LastFiredTime)
+	nextFireTime, err := time.Parse(time.RFC3339, track.NextFireTime)
+	require.NoError(t, err)
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	assert.Equal(t, 9, nextFireTime.In(berlin).Hour())
+}
+
+func TestCronWithoutFireTimeLeft(t *testing.T) {
+	// the first fire time after the due time is beyond the five years searched for it
+	const period, dueTime = "0 0 29 2 *", "2096-03-01T00:00:00Z"
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+
+	t.Run("reminder is deleted without firing", func(t *testing.T) {
+		appChannel := &failingAppChannel{}
+		testActorsRuntime := newTestActorsRuntimeWithMock(appChannel)
+		reminder := createReminderData(actorID, actorType, "reminder1", period, dueTime, "", "a")
+		require.NoError(t, testActorsRuntime.CreateReminder(ctx, &reminder))
+
+		assert.Eventually(t, func() bool {
+			_, exists := testActorsRuntime.getReminder("reminder1", actorType, actorID)
+			return !exists
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 0, appChannel.callCount())
+	})
+	t.Run("timer is deleted without firing", func(t *testing.T) {
+		appChannel := &failingAppChannel{}
+		testActorsRuntime := newTestActorsRuntimeWithMock(appChannel)
+		actorKey := constructCompositeKey(actorType, actorID)
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+		timer := createTimerData(actorID, actorType, "timer1", period, dueTime, "", "callback", "")
+		require.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+
+		assert.Eventually(t, func() bool {
+			_, exists := testActorsRuntime.activeTimers.Load(constructCompositeKey(actorKey, "timer1"))
+			return !exists
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 0, appChannel.callCount())
+	})
+}
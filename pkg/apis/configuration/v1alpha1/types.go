@@ -69,8 +69,10 @@ type APIAccessRule struct {
 
 // NameResolutionSpec is the spec for name resolution configuration.
 type NameResolutionSpec struct {
-	Component     string       `json:"component"`
-	Version       string       `json:"version"`
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	// +optional
+	Plugin        string       `json:"plugin,omitempty"`
 	Configuration DynamicValue `json:"configuration"`
 }
 
@@ -19,7 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -31,13 +31,26 @@ type PluginSpec struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	Type string `json:"type"`
+	// Version is the version of the plugin type
+	// +optional
+	Version string `json:"version,omitempty"`
 
 	Container *Container `json:"container"`
 	Run       *Run       `json:"run"`
+	// +optional
+	Remote *Remote `json:"remote,omitempty"`
+	// +optional
+	TLS *TLS `json:"tls,omitempty"`
 
 	Components []Component `json:"components"`
 
 	Metadata []MetadataItem `json:"metadata"`
+	// IgnoreErrors keeps daprd running when the plugin fails to load
+	// +optional
+	IgnoreErrors bool `json:"ignoreErrors,omitempty"`
+	// InitTimeout bounds the initialization of the plugin, e.g. 10s. Defaults to the component init timeout
+	// +optional
+	InitTimeout string `json:"initTimeout,omitempty"`
 }
 
 // ContainerSpec defines the desired container for the plugin
@@ -46,6 +59,27 @@ type Container struct {
 	Tag        string `json:"tag"`
 }
 
+// Remote defines the address of a plugin that is already running
+type Remote struct {
+	Address string `json:"address"`
+}
+
+// TLS defines the transport security of the connection to a plugin that is reached over the network
+type TLS struct {
+	// Mode is insecure, tls or mtls. Defaults to insecure
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// CACert is the PEM encoded CA certificate verifying the plugin in tls mode
+	// +optional
+	CACert string `json:"caCert,omitempty"`
+	// ServerName overrides the name verified in the certificate of the plugin
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+	// AppID is the Dapr ID of the plugin verified in mtls mode. Defaults to the plugin name
+	// +optional
+	AppID string `json:"appID,omitempty"`
+}
+
 // RunSpec defines the desired run command for the plugin
 type Run struct {
 	Name    string `json:"name"`
@@ -59,24 +93,18 @@ type Component struct {
 	ComponentType string `json:"componentType"`
 }
 
-// MetadataItem is a name/value pair for a metadata.
-type MetadataItem struct {
-	Name string `json:"name"`
-	// +optional
-	Value DynamicValue `json:"value,omitempty"`
-	// +optional
-	SecretKeyRef SecretKeyRef `json:"secretKeyRef,omitempty"`
-}
+// MetadataItem is a name/value pair for a metadata. Plugins share the metadata of components, so their secrets are resolved the same way.
+type MetadataItem = components_v1alpha1.MetadataItem
 
 // SecretKeyRef is a reference to a secret holding the value for the metadata item. Name is the secret name, and key is the field in the secret.
-type SecretKeyRef struct {
-	Name string `json:"name"`
-	Key  string `json:"key"`
-}
+type SecretKeyRef = components_v1alpha1.SecretKeyRef
 
-// DynamicValue is a dynamic value struct for the component.metadata pair value.
-type DynamicValue struct {
-	v1.JSON `json:",inline"`
+// DynamicValue is a dynamic value struct for the plugin.metadata pair value.
+type DynamicValue = components_v1alpha1.DynamicValue
+
+// Auth represents authentication details for the plugin.
+type Auth struct {
+	SecretStore string `json:"secretStore"`
 }
 
 // PluginStatus defines the observed state of Plugin
@@ -93,7 +121,9 @@ type Plugin struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   PluginSpec   `json:"spec,omitempty"`
+	Spec PluginSpec `json:"spec,omitempty"`
+	// +optional
+	Auth   `json:"auth,omitempty"`
 	Status PluginStatus `json:"status,omitempty"`
 }
 
@@ -25,64 +25,46 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Component) DeepCopyInto(out *Component) {
+func (in *Auth) DeepCopyInto(out *Auth) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
-func (in *Component) DeepCopy() *Component {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auth.
+func (in *Auth) DeepCopy() *Auth {
 	if in == nil {
 		return nil
 	}
-	out := new(Component)
+	out := new(Auth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Container) DeepCopyInto(out *Container) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Container.
-func (in *Container) DeepCopy() *Container {
-	if in == nil {
-		return nil
-	}
-	out := new(Container)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicValue) DeepCopyInto(out *DynamicValue) {
+func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
-	in.JSON.DeepCopyInto(&out.JSON)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicValue.
-func (in *DynamicValue) DeepCopy() *DynamicValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
+func (in *Component) DeepCopy() *Component {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicValue)
+	out := new(Component)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetadataItem) DeepCopyInto(out *MetadataItem) {
+func (in *Container) DeepCopyInto(out *Container) {
 	*out = *in
-	in.Value.DeepCopyInto(&out.Value)
-	out.SecretKeyRef = in.SecretKeyRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataItem.
-func (in *MetadataItem) DeepCopy() *MetadataItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Container.
+func (in *Container) DeepCopy() *Container {
 	if in == nil {
 		return nil
 	}
-	out := new(MetadataItem)
+	out := new(Container)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -93,6 +75,7 @@ func (in *Plugin) DeepCopyInto(out *Plugin) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	out.Auth = in.Auth
 	out.Status = in.Status
 }
 
@@ -149,8 +132,26 @@ func (in *PluginList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginSpec) DeepCopyInto(out *PluginSpec) {
 	*out = *in
-	out.Container = in.Container
-	out.Run = in.Run
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(Container)
+		**out = **in
+	}
+	if in.Run != nil {
+		in, out := &in.Run, &out.Run
+		*out = new(Run)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(Remote)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		**out = **in
+	}
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make([]Component, len(*in))
@@ -191,31 +192,46 @@ func (in *PluginStatus) DeepCopy() *PluginStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Run) DeepCopyInto(out *Run) {
+func (in *Remote) DeepCopyInto(out *Remote) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Run.
-func (in *Run) DeepCopy() *Run {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Remote.
+func (in *Remote) DeepCopy() *Remote {
 	if in == nil {
 		return nil
 	}
-	out := new(Run)
+	out := new(Remote)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
-func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLS.
+func (in *TLS) DeepCopy() *TLS {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeyRef)
+	out := new(TLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Run) DeepCopyInto(out *Run) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Run.
+func (in *Run) DeepCopy() *Run {
+	if in == nil {
+		return nil
+	}
+	out := new(Run)
 	in.DeepCopyInto(out)
 	return out
 }
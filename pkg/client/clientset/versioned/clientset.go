@@ -27,12 +27,14 @@ import (
 
 	componentsv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/components/v1alpha1"
 	configurationv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/configuration/v1alpha1"
+	pluginsv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/plugins/v1alpha1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	ComponentsV1alpha1() componentsv1alpha1.ComponentsV1alpha1Interface
 	ConfigurationV1alpha1() configurationv1alpha1.ConfigurationV1alpha1Interface
+	PluginsV1alpha1() pluginsv1alpha1.PluginsV1alpha1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -41,6 +43,7 @@ type Clientset struct {
 	*discovery.DiscoveryClient
 	componentsV1alpha1    *componentsv1alpha1.ComponentsV1alpha1Client
 	configurationV1alpha1 *configurationv1alpha1.ConfigurationV1alpha1Client
+	pluginsV1alpha1       *pluginsv1alpha1.PluginsV1alpha1Client
 }
 
 // ComponentsV1alpha1 retrieves the ComponentsV1alpha1Client
@@ -53,6 +56,11 @@ func (c *Clientset) ConfigurationV1alpha1() configurationv1alpha1.ConfigurationV
 	return c.configurationV1alpha1
 }
 
+// PluginsV1alpha1 retrieves the PluginsV1alpha1Client
+func (c *Clientset) PluginsV1alpha1() pluginsv1alpha1.PluginsV1alpha1Interface {
+	return c.pluginsV1alpha1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -82,6 +90,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.pluginsV1alpha1, err = pluginsv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -96,6 +108,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.componentsV1alpha1 = componentsv1alpha1.NewForConfigOrDie(c)
 	cs.configurationV1alpha1 = configurationv1alpha1.NewForConfigOrDie(c)
+	cs.pluginsV1alpha1 = pluginsv1alpha1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -106,6 +119,7 @@ func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.componentsV1alpha1 = componentsv1alpha1.New(c)
 	cs.configurationV1alpha1 = configurationv1alpha1.New(c)
+	cs.pluginsV1alpha1 = pluginsv1alpha1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
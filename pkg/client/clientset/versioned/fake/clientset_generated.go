@@ -30,6 +30,8 @@ import (
 	fakecomponentsv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/components/v1alpha1/fake"
 	configurationv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/configuration/v1alpha1"
 	fakeconfigurationv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/configuration/v1alpha1/fake"
+	pluginsv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/plugins/v1alpha1"
+	fakepluginsv1alpha1 "github.com/dapr/dapr/pkg/client/clientset/versioned/typed/plugins/v1alpha1/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -88,3 +90,8 @@ func (c *Clientset) ComponentsV1alpha1() componentsv1alpha1.ComponentsV1alpha1In
 func (c *Clientset) ConfigurationV1alpha1() configurationv1alpha1.ConfigurationV1alpha1Interface {
 	return &fakeconfigurationv1alpha1.FakeConfigurationV1alpha1{Fake: &c.Fake}
 }
+
+// PluginsV1alpha1 retrieves the PluginsV1alpha1Client
+func (c *Clientset) PluginsV1alpha1() pluginsv1alpha1.PluginsV1alpha1Interface {
+	return &fakepluginsv1alpha1.FakePluginsV1alpha1{Fake: &c.Fake}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dapr/kit/logger"
 
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
@@ -73,3 +74,26 @@ func (k *KubernetesComponents) LoadComponents() ([]components_v1alpha1.Component
 	}
 	return components, nil
 }
+
+// LoadPlugins returns plugins from a given control plane address.
+func (k *KubernetesComponents) LoadPlugins() ([]plugins_v1alpha1.Plugin, error) {
+	resp, err := k.client.ListPlugins(context.Background(), &operatorv1pb.ListPluginsRequest{
+		Namespace: k.namespace,
+	}, grpc_retry.WithMax(operatorMaxRetries), grpc_retry.WithPerRetryTimeout(operatorCallTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := []plugins_v1alpha1.Plugin{}
+	for _, p := range resp.GetPlugins() {
+		var plugin plugins_v1alpha1.Plugin
+		plugin.Spec = plugins_v1alpha1.PluginSpec{}
+		err := json.Unmarshal(p, &plugin)
+		if err != nil {
+			log.Warnf("error deserializing plugin: %s", err)
+			continue
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
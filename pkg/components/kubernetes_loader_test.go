@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	plugins "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	subscriptions "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
@@ -40,6 +41,19 @@ func (o *mockOperator) ListComponents(ctx context.Context, in *operatorv1pb.List
 	}, nil
 }
 
+func (o *mockOperator) ListPlugins(ctx context.Context, in *operatorv1pb.ListPluginsRequest) (*operatorv1pb.ListPluginsResponse, error) {
+	plugin := plugins.Plugin{}
+	plugin.ObjectMeta.Name = "test"
+	plugin.Spec = plugins.PluginSpec{
+		Type: "GRPC",
+	}
+	b, _ := json.Marshal(&plugin)
+
+	return &operatorv1pb.ListPluginsResponse{
+		Plugins: [][]byte{b},
+	}, nil
+}
+
 func (o *mockOperator) ListSubscriptions(ctx context.Context, in *emptypb.Empty) (*operatorv1pb.ListSubscriptionsResponse, error) {
 	subscription := subscriptions.Subscription{}
 	subscription.ObjectMeta.Name = "test"
@@ -92,3 +106,32 @@ func TestLoadComponents(t *testing.T) {
 	assert.Equal(t, "test", response[0].Name)
 	assert.Equal(t, "testtype", response[0].Spec.Type)
 }
+
+func TestLoadPlugins(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	assert.NoError(t, err)
+
+	s := grpc.NewServer()
+	operatorv1pb.RegisterOperatorServer(s, &mockOperator{})
+	defer s.Stop()
+
+	go func() {
+		s.Serve(lis)
+	}()
+
+	time.Sleep(time.Second * 1)
+
+	request := &KubernetesComponents{
+		client: getOperatorClient(fmt.Sprintf("localhost:%d", port)),
+		config: config.KubernetesConfig{
+			ControlPlaneAddress: fmt.Sprintf("localhost:%v", port),
+		},
+	}
+
+	response, err := request.LoadPlugins()
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "test", response[0].Name)
+	assert.Equal(t, "GRPC", response[0].Spec.Type)
+}
@@ -14,6 +14,11 @@ limitations under the License.
 package plugin
 
 import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dapr/dapr/pkg/components"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/plugin"
 )
@@ -23,6 +28,9 @@ type (
 
 	// Plugin is a plugin component definition.
 	Plugin struct {
+		// Type is the plugin type, optionally versioned, e.g. exec or exec/v2
+		Type string
+		// Mode is the runtime mode the factory is bound to. An empty mode binds the factory to every mode
 		Mode          modes.DaprMode
 		FactoryMethod FactoryMethod
 	}
@@ -30,39 +38,58 @@ type (
 	// Registry is the interface for callers to get registered plugin components.
 	Registry interface {
 		Register(mode modes.DaprMode, components ...Plugin)
-		Create(plugin.Config) (plugin.Plugin, error)
+		Create(pluginType, version string, cfg plugin.Config) (plugin.Plugin, error)
 	}
 
 	pluginRegistry struct {
-		factory FactoryMethod
+		plugins map[string]FactoryMethod
 	}
 )
 
 // New creates a Plugin Factory.
-func New(mode modes.DaprMode, factoryMethod FactoryMethod) Plugin {
+func New(pluginType string, mode modes.DaprMode, factoryMethod FactoryMethod) Plugin {
 	return Plugin{
-		FactoryMethod: factoryMethod,
+		Type:          pluginType,
 		Mode:          mode,
+		FactoryMethod: factoryMethod,
 	}
 }
 
-// NewRegistry returns a new pub sub registry.
+// NewRegistry returns a new plugin registry.
 func NewRegistry() Registry {
-	return &pluginRegistry{}
+	return &pluginRegistry{
+		plugins: map[string]FactoryMethod{},
+	}
 }
 
-// Register attempts to match the given runtime mode to the list of components
-// See the WithPlugin methods in the runtime to see how plugin factories are bound
+// Register registers the factories bound to the given runtime mode, keyed by plugin type.
+// See the WithPlugins methods in the runtime to see how plugin factories are bound
 func (p *pluginRegistry) Register(mode modes.DaprMode, components ...Plugin) {
 	for _, component := range components {
-		if component.Mode == mode {
-			p.factory = component.FactoryMethod
-			return
+		if component.Mode != "" && component.Mode != mode {
+			continue
 		}
+		p.plugins[strings.ToLower(component.Type)] = component.FactoryMethod
 	}
 }
 
-// Creates an instance of the plugin
-func (p *pluginRegistry) Create(cfg plugin.Config) (plugin.Plugin, error) {
-	return p.factory(cfg)
+// Create creates an instance of the plugin of the given type.
+func (p *pluginRegistry) Create(pluginType, version string, cfg plugin.Config) (plugin.Plugin, error) {
+	if method, ok := p.getPlugin(pluginType, version); ok {
+		return method(cfg)
+	}
+	return nil, errors.Errorf("couldn't find plugin type %s/%s", pluginType, version)
+}
+
+func (p *pluginRegistry) getPlugin(pluginType, version string) (FactoryMethod, bool) {
+	typeLower := strings.ToLower(pluginType)
+	versionLower := strings.ToLower(version)
+	pluginFn, ok := p.plugins[typeLower+"/"+versionLower]
+	if ok {
+		return pluginFn, true
+	}
+	if components.IsInitialVersion(versionLower) {
+		pluginFn, ok = p.plugins[typeLower]
+	}
+	return pluginFn, ok
 }
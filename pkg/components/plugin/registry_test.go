@@ -17,6 +17,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/dapr/dapr/pkg/modes"
@@ -29,60 +30,92 @@ func TestCreatePlugin(t *testing.T) {
 
 	t.Run("plugin is registered", func(t *testing.T) {
 		const (
-			pluginName    = "mockPlugin"
-			pluginNameV2  = "mockPlugin/v2"
-			componentType = "store"
-			componentName = componentType + "." + pluginName
+			pluginType   = "mockPlugin"
+			pluginTypeV2 = "mockPlugin/v2"
 		)
 
 		// Initiate mock object
 		mockPlugin := new(daprt.MockPlugin)
 		mockPluginV2 := new(daprt.MockPlugin)
+		cfg := plugin.Config{Name: "name", Version: "version"}
 
 		// act
-		testRegistry.Register(modes.KubernetesMode, New(modes.KubernetesMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+		testRegistry.Register(modes.KubernetesMode, New(pluginType, modes.KubernetesMode, func(c plugin.Config) (plugin.Plugin, error) {
+			assert.Equal(t, cfg, c)
 			return mockPlugin, nil
 		}))
-		testRegistry.Register(modes.KubernetesMode, New(modes.KubernetesMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+		testRegistry.Register(modes.KubernetesMode, New(pluginTypeV2, modes.KubernetesMode, func(c plugin.Config) (plugin.Plugin, error) {
 			return mockPluginV2, nil
 		}))
 
 		// assert v0 and v1
-		p, e := testRegistry.Create(
-			plugin.Config{
-				Name:    componentName,
-				Version: pluginName,
-				Type:    "v0",
-			})
+		p, e := testRegistry.Create(pluginType, "v0", cfg)
 		assert.NoError(t, e)
 		assert.Same(t, mockPlugin, p)
 
-		p, e = testRegistry.Create(
-			plugin.Config{
-				Name:    componentName,
-				Version: pluginName,
-				Type:    "v1",
-			})
+		p, e = testRegistry.Create(pluginType, "v1", cfg)
+		assert.NoError(t, e)
+		assert.Same(t, mockPlugin, p)
+
+		p, e = testRegistry.Create(pluginType, "", cfg)
 		assert.NoError(t, e)
 		assert.Same(t, mockPlugin, p)
 
 		// assert v2
-		pV2, e := testRegistry.Create(plugin.Config{
-			Name:    "string",
-			Version: "string",
-			Type:    "string",
-		})
+		pV2, e := testRegistry.Create(pluginType, "v2", cfg)
 		assert.NoError(t, e)
 		assert.Same(t, mockPluginV2, pV2)
 
 		// check case-insensitivity
-		pV2, e = testRegistry.Create(
-			plugin.Config{
-				Name:    strings.ToUpper(componentName),
-				Version: pluginName,
-				Type:    "V2",
-			})
+		pV2, e = testRegistry.Create(strings.ToUpper(pluginType), "V2", cfg)
 		assert.NoError(t, e)
 		assert.Same(t, mockPluginV2, pV2)
 	})
+
+	t.Run("plugin types are kept apart", func(t *testing.T) {
+		grpcPlugin := new(daprt.MockPlugin)
+		remotePlugin := new(daprt.MockPlugin)
+
+		testRegistry.Register(modes.StandaloneMode,
+			New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+				return grpcPlugin, nil
+			}),
+			New(plugin.TypeRemoteAddress, "", func(cfg plugin.Config) (plugin.Plugin, error) {
+				return remotePlugin, nil
+			}),
+		)
+
+		p, e := testRegistry.Create(plugin.TypeGRPC, "", plugin.Config{})
+		assert.NoError(t, e)
+		assert.Same(t, grpcPlugin, p)
+
+		p, e = testRegistry.Create(plugin.TypeRemoteAddress, "", plugin.Config{})
+		assert.NoError(t, e)
+		assert.Same(t, remotePlugin, p)
+	})
+
+	t.Run("plugin of another mode is not registered", func(t *testing.T) {
+		registry := NewRegistry()
+		registry.Register(modes.StandaloneMode, New(plugin.TypeContainer, modes.KubernetesMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+			return new(daprt.MockPlugin), nil
+		}))
+
+		p, e := registry.Create(plugin.TypeContainer, "", plugin.Config{})
+		assert.EqualError(t, e, "couldn't find plugin type container/")
+		assert.Nil(t, p)
+	})
+
+	t.Run("plugin is not registered", func(t *testing.T) {
+		const (
+			pluginType = "fakePlugin"
+		)
+
+		// act
+		p, actualError := testRegistry.Create(pluginType, "v1", plugin.Config{})
+		expectedError := errors.Errorf("couldn't find plugin type %s/v1", pluginType)
+
+		// assert
+		assert.Nil(t, p)
+		assert.Equal(t, expectedError.Error(), actualError.Error())
+	})
 }
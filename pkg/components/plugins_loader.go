@@ -0,0 +1,21 @@
+/*
+Copyright 2021 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
+
+// PluginLoader is an interface for returning Dapr plugins.
+type PluginLoader interface {
+	LoadPlugins() ([]plugins_v1alpha1.Plugin, error)
+}
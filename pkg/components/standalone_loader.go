@@ -25,12 +25,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 )
 
 const (
 	yamlSeparator = "\n---"
 	componentKind = "Component"
+	pluginKind    = "Plugin"
 )
 
 // StandaloneComponents loads components in a standalone mode environment.
@@ -47,6 +49,44 @@ func NewStandaloneComponents(configuration config.StandaloneConfig) *StandaloneC
 
 // LoadComponents loads dapr components from a given directory.
 func (s *StandaloneComponents) LoadComponents() ([]components_v1alpha1.Component, error) {
+	files, err := s.listYamlFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	list := []components_v1alpha1.Component{}
+
+	for _, file := range files {
+		components := s.loadComponentsFromFile(file)
+		if len(components) > 0 {
+			list = append(list, components...)
+		}
+	}
+
+	return list, nil
+}
+
+// LoadPlugins loads dapr plugins from a given directory.
+func (s *StandaloneComponents) LoadPlugins() ([]plugins_v1alpha1.Plugin, error) {
+	files, err := s.listYamlFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	list := []plugins_v1alpha1.Plugin{}
+
+	for _, file := range files {
+		plugins := s.loadPluginsFromFile(file)
+		if len(plugins) > 0 {
+			list = append(list, plugins...)
+		}
+	}
+
+	return list, nil
+}
+
+// listYamlFiles returns the names of the yaml files in the components directory.
+func (s *StandaloneComponents) listYamlFiles() ([]string, error) {
 	if s.config.ComponentsPath == "" {
 		return nil, fmt.Errorf("no component path specified")
 	}
@@ -58,18 +98,13 @@ func (s *StandaloneComponents) LoadComponents() ([]components_v1alpha1.Component
 		return nil, err
 	}
 
-	list := []components_v1alpha1.Component{}
-
+	names := []string{}
 	for _, file := range files {
 		if !file.IsDir() && s.isYaml(file.Name()) {
-			components := s.loadComponentsFromFile(file.Name())
-			if len(components) > 0 {
-				list = append(list, components...)
-			}
+			names = append(names, file.Name())
 		}
 	}
-
-	return list, nil
+	return names, nil
 }
 
 func (s *StandaloneComponents) loadComponentsFromFile(filename string) []components_v1alpha1.Component {
@@ -90,6 +125,24 @@ func (s *StandaloneComponents) loadComponentsFromFile(filename string) []compone
 	return components
 }
 
+func (s *StandaloneComponents) loadPluginsFromFile(filename string) []plugins_v1alpha1.Plugin {
+	var errors []error
+
+	plugins := []plugins_v1alpha1.Plugin{}
+	path := filepath.Join(s.config.ComponentsPath, filename)
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		log.Warnf("daprd load plugins error when reading file %s : %s", path, err)
+		return plugins
+	}
+	plugins, errors = s.decodePluginsYaml(b)
+	for _, err := range errors {
+		log.Warnf("daprd load plugins error when parsing plugins yaml resource in %s : %s", path, err)
+	}
+	return plugins
+}
+
 // isYaml checks whether the file is yaml or not.
 func (s *StandaloneComponents) isYaml(fileName string) bool {
 	extension := strings.ToLower(filepath.Ext(fileName))
@@ -102,6 +155,39 @@ func (s *StandaloneComponents) isYaml(fileName string) bool {
 // decodeYaml decodes the yaml document.
 func (s *StandaloneComponents) decodeYaml(b []byte) ([]components_v1alpha1.Component, []error) {
 	list := []components_v1alpha1.Component{}
+	errors := s.decodeYamlDocuments(b, componentKind, func(doc []byte) error {
+		var comp components_v1alpha1.Component
+		comp.Spec = components_v1alpha1.ComponentSpec{}
+		if err := yaml.Unmarshal(doc, &comp); err != nil {
+			return err
+		}
+
+		list = append(list, comp)
+		return nil
+	})
+
+	return list, errors
+}
+
+// decodePluginsYaml decodes the plugins in the yaml document.
+func (s *StandaloneComponents) decodePluginsYaml(b []byte) ([]plugins_v1alpha1.Plugin, []error) {
+	list := []plugins_v1alpha1.Plugin{}
+	errors := s.decodeYamlDocuments(b, pluginKind, func(doc []byte) error {
+		var plugin plugins_v1alpha1.Plugin
+		plugin.Spec = plugins_v1alpha1.PluginSpec{}
+		if err := yaml.Unmarshal(doc, &plugin); err != nil {
+			return err
+		}
+
+		list = append(list, plugin)
+		return nil
+	})
+
+	return list, errors
+}
+
+// decodeYamlDocuments splits the yaml document and calls decode for every resource of the given kind.
+func (s *StandaloneComponents) decodeYamlDocuments(b []byte, kind string, decode func(doc []byte) error) []error {
 	errors := []error{}
 	scanner := bufio.NewScanner(bytes.NewReader(b))
 	scanner.Split(s.splitYamlDoc)
@@ -130,22 +216,16 @@ func (s *StandaloneComponents) decodeYaml(b []byte) ([]components_v1alpha1.Compo
 			continue
 		}
 
-		if ti.Kind != componentKind {
+		if ti.Kind != kind {
 			continue
 		}
 
-		var comp components_v1alpha1.Component
-		comp.Spec = components_v1alpha1.ComponentSpec{}
-		if err := yaml.Unmarshal(scannerBytes, &comp); err != nil {
+		if err := decode(scannerBytes); err != nil {
 			errors = append(errors, err)
-
-			continue
 		}
-
-		list = append(list, comp)
 	}
 
-	return list, errors
+	return errors
 }
 
 // splitYamlDoc - splits the yaml docs.
@@ -283,3 +283,47 @@ spec:
 	assert.Equal(t, "prop3", components[1].Spec.Metadata[0].Name)
 	assert.Equal(t, "value3", components[1].Spec.Metadata[0].Value.String())
 }
+
+func TestStandaloneDecodePluginsYaml(t *testing.T) {
+	request := &StandaloneComponents{
+		config: config.StandaloneConfig{
+			ComponentsPath: "test_component_path",
+		},
+	}
+	yaml := `
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+   name: statestore
+spec:
+   type: state.couchbase
+   plugin: GRPC
+---
+apiVersion: dapr.io/v1alpha1
+kind: Plugin
+metadata:
+   name: memory
+spec:
+   type: GRPC
+   run:
+     name: gomemory
+     version: v0.0.1
+   components:
+   - name: statestore
+     componentType: state
+`
+	plugins, errs := request.decodePluginsYaml([]byte(yaml))
+	assert.Len(t, plugins, 1)
+	assert.Empty(t, errs)
+	assert.Equal(t, "memory", plugins[0].Name)
+	assert.Equal(t, "GRPC", plugins[0].Spec.Type)
+	assert.Equal(t, "gomemory", plugins[0].Spec.Run.Name)
+	assert.Equal(t, "v0.0.1", plugins[0].Spec.Run.Version)
+	assert.Len(t, plugins[0].Spec.Components, 1)
+	assert.Equal(t, "statestore", plugins[0].Spec.Components[0].Name)
+
+	components, errs := request.decodeYaml([]byte(yaml))
+	assert.Len(t, components, 1)
+	assert.Empty(t, errs)
+	assert.Equal(t, "statestore", components[0].Name)
+}
@@ -25,6 +25,41 @@ type ApplicationConfig struct {
 	DrainRebalancedActors      bool             `json:"drainRebalancedActors"`
 	Reentrancy                 ReentrancyConfig `json:"reentrancy,omitempty"`
 	RemindersStoragePartitions int              `json:"remindersStoragePartitions"`
+	// ReminderFailurePolicies are the policies applied when the app fails to handle a reminder, by actor type.
+	// The reminder failure policy of the entities config of an actor type takes precedence
+	ReminderFailurePolicies []ReminderFailurePolicyConfig `json:"reminderFailurePolicies,omitempty"`
+	// EntitiesConfig overrides the actor settings above for some actor types
+	EntitiesConfig []EntityConfig `json:"entitiesConfig,omitempty"`
+}
+
+// EntityConfig overrides the actor settings of the application config for the actor types.
+// The settings that are not set keep the values of the application config.
+type EntityConfig struct {
+	Entities []string `json:"entities"`
+	// Duration. example: "1h"
+	ActorIdleTimeout string `json:"actorIdleTimeout,omitempty"`
+	// Duration. example: "30s"
+	DrainOngoingCallTimeout    string            `json:"drainOngoingCallTimeout,omitempty"`
+	DrainRebalancedActors      *bool             `json:"drainRebalancedActors,omitempty"`
+	Reentrancy                 *ReentrancyConfig `json:"reentrancy,omitempty"`
+	RemindersStoragePartitions *int              `json:"remindersStoragePartitions,omitempty"`
+	// ReminderFailurePolicy is applied when the app fails to handle a reminder of the actor types
+	ReminderFailurePolicy *ReminderFailurePolicyConfig `json:"reminderFailurePolicy,omitempty"`
+}
+
+// ReminderFailurePolicyConfig is the policy applied when the app fails to handle a reminder.
+type ReminderFailurePolicyConfig struct {
+	// Entities are the actor types of the policies of the application config. They are ignored in the entities config
+	Entities []string `json:"entities,omitempty"`
+	// MaxRetries is the number of retries of a failed reminder delivery
+	MaxRetries int `json:"maxRetries"`
+	// Duration of the first backoff, doubled on each retry. example: "1s"
+	Backoff string `json:"backoff,omitempty"`
+	// Duration. example: "1m"
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+	// DeadLetterPubsub and DeadLetterTopic are where the reminders still failing after all retries are published
+	DeadLetterPubsub string `json:"deadLetterPubsub,omitempty"`
+	DeadLetterTopic  string `json:"deadLetterTopic,omitempty"`
 }
 
 type ReentrancyConfig struct {
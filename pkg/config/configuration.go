@@ -178,8 +178,10 @@ type AccessControlSpec struct {
 }
 
 type NameResolutionSpec struct {
-	Component     string      `json:"component" yaml:"component"`
-	Version       string      `json:"version" yaml:"version"`
+	Component string `json:"component" yaml:"component"`
+	Version   string `json:"version" yaml:"version"`
+	// Plugin is set to grpc when the component is served by a plugin
+	Plugin        string      `json:"plugin,omitempty" yaml:"plugin,omitempty"`
 	Configuration interface{} `json:"configuration" yaml:"configuration"`
 }
 
@@ -16,4 +16,6 @@ package config
 // KubernetesConfig defines the configuration for Kubernetes mode.
 type KubernetesConfig struct {
 	ControlPlaneAddress string
+	// PluginsDiscoveryFile is the path of a static file locating the plugin containers, which takes precedence over the environment
+	PluginsDiscoveryFile string
 }
@@ -23,6 +23,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/runtime/protoimpl"
 
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
@@ -171,7 +173,14 @@ func (g *grpcMetrics) ClientRequestReceived(ctx context.Context, method, status
 }
 
 func (g *grpcMetrics) getPayloadSize(payload interface{}) int {
-	return proto.Size(payload.(proto.Message))
+	switch m := payload.(type) {
+	case proto.Message:
+		return proto.Size(m)
+	case protoiface.MessageV1:
+		// plugin connections also carry the messages of the go-plugin services, generated with the legacy api
+		return proto.Size(protoimpl.X.ProtoMessageV2Of(m))
+	}
+	return 0
 }
 
 // UnaryServerInterceptor is a gRPC server-side interceptor for Unary RPCs.
@@ -137,6 +137,30 @@ func GRPCTraceStreamServerInterceptor(appID string, spec config.TracingSpec) grp
 	}
 }
 
+// GRPCTraceUnaryClientInterceptor starts a client span for calls made within a traced request.
+// The span context is propagated to the server in the W3C traceparent and tracestate metadata.
+func GRPCTraceUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		parent := diag_utils.SpanFromContext(ctx)
+		if parent == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, span := trace.StartSpan(trace.NewContext(ctx, parent), method, trace.WithSpanKind(trace.SpanKindClient))
+		defer span.End()
+
+		sc := span.SpanContext()
+		ctx = metadata.AppendToOutgoingContext(ctx, traceparentHeader, SpanContextToW3CString(sc))
+		if sc.Tracestate != nil {
+			ctx = metadata.AppendToOutgoingContext(ctx, tracestateHeader, TraceStateToW3CString(sc))
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		UpdateSpanStatusFromGRPCError(span, err)
+		return err
+	}
+}
+
 func addSpanMetadataAndUpdateStatus(ctx context.Context, span *trace.Span, fullMethod, appID string, req interface{}, stream bool) {
 	var prefixedMetadata map[string]string
 	if span.SpanContext().TraceOptions.IsSampled() {
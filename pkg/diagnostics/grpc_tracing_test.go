@@ -187,6 +187,46 @@ func TestGRPCTraceUnaryServerInterceptor(t *testing.T) {
 	})
 }
 
+func TestGRPCTraceUnaryClientInterceptor(t *testing.T) {
+	interceptor := GRPCTraceUnaryClientInterceptor()
+	method := "/dapr.proto.state.v1.Store/Get"
+
+	t.Run("traceparent is propagated for traced calls", func(t *testing.T) {
+		testTraceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		testSpanContext, _ := SpanContextFromW3CString(testTraceParent)
+		ctx, parent := trace.StartSpanWithRemoteParent(context.Background(), "parent", testSpanContext)
+		defer parent.End()
+
+		var md metadata.MD
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return errors.New("fake error")
+		}
+
+		err := interceptor(ctx, method, nil, nil, nil, invoker)
+		assert.Error(t, err)
+
+		traceparent := md.Get(traceparentHeader)
+		assert.Len(t, traceparent, 1)
+		sc, ok := SpanContextFromW3CString(traceparent[0])
+		assert.True(t, ok)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", fmt.Sprintf("%x", sc.TraceID[:]))
+		assert.NotEqual(t, parent.SpanContext().SpanID, sc.SpanID)
+	})
+
+	t.Run("calls without a span are not traced", func(t *testing.T) {
+		var md metadata.MD
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		}
+
+		err := interceptor(context.Background(), method, nil, nil, nil, invoker)
+		assert.NoError(t, err)
+		assert.Empty(t, md.Get(traceparentHeader))
+	})
+}
+
 func TestSpanContextSerialization(t *testing.T) {
 	wantSc := trace.SpanContext{
 		TraceID:      trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
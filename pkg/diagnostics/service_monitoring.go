@@ -19,6 +19,7 @@ var (
 	trustDomainKey  = tag.MustNewKey("trustDomain")
 	namespaceKey    = tag.MustNewKey("namespace")
 	policyActionKey = tag.MustNewKey("policyAction")
+	pluginKey       = tag.MustNewKey("plugin")
 )
 
 // serviceMetrics holds dapr runtime metric monitoring methods.
@@ -35,13 +36,17 @@ type serviceMetrics struct {
 	mtlsWorkloadCertRotatedFailed *stats.Int64Measure
 
 	// Actor metrics
-	actorStatusReportTotal       *stats.Int64Measure
-	actorStatusReportFailedTotal *stats.Int64Measure
-	actorTableOperationRecvTotal *stats.Int64Measure
-	actorRebalancedTotal         *stats.Int64Measure
-	actorDeactivationTotal       *stats.Int64Measure
-	actorDeactivationFailedTotal *stats.Int64Measure
-	actorPendingCalls            *stats.Int64Measure
+	actorStatusReportTotal             *stats.Int64Measure
+	actorStatusReportFailedTotal       *stats.Int64Measure
+	actorTableOperationRecvTotal       *stats.Int64Measure
+	actorRebalancedTotal               *stats.Int64Measure
+	actorDeactivationTotal             *stats.Int64Measure
+	actorDeactivationFailedTotal       *stats.Int64Measure
+	actorPendingCalls                  *stats.Int64Measure
+	actorReminderRetryTotal            *stats.Int64Measure
+	actorReminderFailedTotal           *stats.Int64Measure
+	actorReminderDeadLetterTotal       *stats.Int64Measure
+	actorReminderDeadLetterFailedTotal *stats.Int64Measure
 
 	// Access Control Lists for Service Invocation metrics
 	appPolicyActionAllowed    *stats.Int64Measure
@@ -49,6 +54,11 @@ type serviceMetrics struct {
 	appPolicyActionBlocked    *stats.Int64Measure
 	globalPolicyActionBlocked *stats.Int64Measure
 
+	// Plugin metrics
+	pluginUp                 *stats.Int64Measure
+	pluginRestartTotal       *stats.Int64Measure
+	pluginRestartFailedTotal *stats.Int64Measure
+
 	appID   string
 	ctx     context.Context
 	enabled bool
@@ -118,6 +128,22 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/actor/pending_actor_calls",
 			"The number of pending actor calls waiting to acquire the per-actor lock.",
 			stats.UnitDimensionless),
+		actorReminderRetryTotal: stats.Int64(
+			"runtime/actor/reminder_retry_total",
+			"The number of the retried reminder deliveries.",
+			stats.UnitDimensionless),
+		actorReminderFailedTotal: stats.Int64(
+			"runtime/actor/reminder_failed_total",
+			"The number of the reminder deliveries that failed after all retries.",
+			stats.UnitDimensionless),
+		actorReminderDeadLetterTotal: stats.Int64(
+			"runtime/actor/reminder_dead_letter_total",
+			"The number of the failed reminder deliveries published to a dead-letter topic.",
+			stats.UnitDimensionless),
+		actorReminderDeadLetterFailedTotal: stats.Int64(
+			"runtime/actor/reminder_dead_letter_fail_total",
+			"The number of the failed reminder deliveries that could not be published to a dead-letter topic.",
+			stats.UnitDimensionless),
 
 		// Access Control Lists for service invocation
 		appPolicyActionAllowed: stats.Int64(
@@ -137,6 +163,20 @@ func newServiceMetrics() *serviceMetrics {
 			"The number of requests blocked by the global action specified in the access control policy.",
 			stats.UnitDimensionless),
 
+		// Plugin
+		pluginUp: stats.Int64(
+			"runtime/plugin/up",
+			"Whether the plugin process is up (1) or down (0).",
+			stats.UnitDimensionless),
+		pluginRestartTotal: stats.Int64(
+			"runtime/plugin/restart_total",
+			"The number of successful plugin process restarts.",
+			stats.UnitDimensionless),
+		pluginRestartFailedTotal: stats.Int64(
+			"runtime/plugin/restart_fail_total",
+			"The number of failed plugin process restarts.",
+			stats.UnitDimensionless),
+
 		// TODO: use the correct context for each request
 		ctx:     context.Background(),
 		enabled: false,
@@ -164,11 +204,19 @@ func (s *serviceMetrics) Init(appID string) error {
 		diag_utils.NewMeasureView(s.actorDeactivationTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
 		diag_utils.NewMeasureView(s.actorDeactivationFailedTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
 		diag_utils.NewMeasureView(s.actorPendingCalls, []tag.Key{appIDKey, actorTypeKey}, view.LastValue()),
+		diag_utils.NewMeasureView(s.actorReminderRetryTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
+		diag_utils.NewMeasureView(s.actorReminderFailedTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
+		diag_utils.NewMeasureView(s.actorReminderDeadLetterTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
+		diag_utils.NewMeasureView(s.actorReminderDeadLetterFailedTotal, []tag.Key{appIDKey, actorTypeKey, failReasonKey}, view.Count()),
 
 		diag_utils.NewMeasureView(s.appPolicyActionAllowed, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.LastValue()),
 		diag_utils.NewMeasureView(s.globalPolicyActionAllowed, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.LastValue()),
 		diag_utils.NewMeasureView(s.appPolicyActionBlocked, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.LastValue()),
 		diag_utils.NewMeasureView(s.globalPolicyActionBlocked, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.LastValue()),
+
+		diag_utils.NewMeasureView(s.pluginUp, []tag.Key{appIDKey, pluginKey}, view.LastValue()),
+		diag_utils.NewMeasureView(s.pluginRestartTotal, []tag.Key{appIDKey, pluginKey}, view.Count()),
+		diag_utils.NewMeasureView(s.pluginRestartFailedTotal, []tag.Key{appIDKey, pluginKey, failReasonKey}, view.Count()),
 	)
 }
 
@@ -298,6 +346,46 @@ func (s *serviceMetrics) ReportActorPendingCalls(actorType string, pendingLocks
 	}
 }
 
+// ActorReminderRetried records metric when a failed reminder delivery is retried.
+func (s *serviceMetrics) ActorReminderRetried(actorType string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
+			s.actorReminderRetryTotal.M(1))
+	}
+}
+
+// ActorReminderFailed records metric when a reminder delivery fails after all retries.
+func (s *serviceMetrics) ActorReminderFailed(actorType string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
+			s.actorReminderFailedTotal.M(1))
+	}
+}
+
+// ActorReminderDeadLettered records metric when a failed reminder delivery is published to the dead-letter topic.
+func (s *serviceMetrics) ActorReminderDeadLettered(actorType string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
+			s.actorReminderDeadLetterTotal.M(1))
+	}
+}
+
+// ActorReminderDeadLetterFailed records metric when a failed reminder delivery cannot be published to the dead-letter topic.
+func (s *serviceMetrics) ActorReminderDeadLetterFailed(actorType, reason string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType, failReasonKey, reason),
+			s.actorReminderDeadLetterFailedTotal.M(1))
+	}
+}
+
 // RequestAllowedByAppAction records the requests allowed due to a match with the action specified in the access control policy for the app.
 func (s *serviceMetrics) RequestAllowedByAppAction(appID, trustDomain, namespace, operation, httpverb string, policyAction bool) {
 	if s.enabled {
@@ -361,3 +449,37 @@ func (s *serviceMetrics) RequestBlockedByGlobalAction(appID, trustDomain, namesp
 			s.globalPolicyActionBlocked.M(1))
 	}
 }
+
+// PluginStatusReported records whether the plugin process is up.
+func (s *serviceMetrics) PluginStatusReported(plugin string, up bool) {
+	if s.enabled {
+		var value int64
+		if up {
+			value = 1
+		}
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, pluginKey, plugin),
+			s.pluginUp.M(value))
+	}
+}
+
+// PluginRestarted records metric when the plugin process is restarted.
+func (s *serviceMetrics) PluginRestarted(plugin string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, pluginKey, plugin),
+			s.pluginRestartTotal.M(1))
+	}
+}
+
+// PluginRestartFailed records metric when the plugin process fails to restart.
+func (s *serviceMetrics) PluginRestartFailed(plugin string, reason string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, pluginKey, plugin, failReasonKey, reason),
+			s.pluginRestartFailedTotal.M(1))
+	}
+}
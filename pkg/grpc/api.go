@@ -15,6 +15,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -52,6 +53,8 @@ import (
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	secretstores_sdk "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
+	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 )
 
 const (
@@ -87,6 +90,8 @@ type API interface {
 	RegisterActorReminder(ctx context.Context, in *runtimev1pb.RegisterActorReminderRequest) (*emptypb.Empty, error)
 	UnregisterActorReminder(ctx context.Context, in *runtimev1pb.UnregisterActorReminderRequest) (*emptypb.Empty, error)
 	RenameActorReminder(ctx context.Context, in *runtimev1pb.RenameActorReminderRequest) (*emptypb.Empty, error)
+	ListActorReminders(ctx context.Context, in *runtimev1pb.ListActorRemindersRequest) (*runtimev1pb.ListActorRemindersResponse, error)
+	ListActorTimers(ctx context.Context, in *runtimev1pb.ListActorTimersRequest) (*runtimev1pb.ListActorTimersResponse, error)
 	GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error)
 	ExecuteActorStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteActorStateTransactionRequest) (*emptypb.Empty, error)
 	InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorRequest) (*runtimev1pb.InvokeActorResponse, error)
@@ -103,7 +108,6 @@ type api struct {
 	directMessaging            messaging.DirectMessaging
 	appChannel                 channel.AppChannel
 	stateStores                map[string]state.Store
-	transactionalStateStores   map[string]state.TransactionalStore
 	secretStores               map[string]secretstores.SecretStore
 	secretsConfiguration       map[string]config.SecretsScope
 	configurationStores        map[string]configuration.Store
@@ -111,7 +115,7 @@ type api struct {
 	configurationSubscribeLock sync.Mutex
 	pubsubAdapter              runtime_pubsub.Adapter
 	id                         string
-	sendToOutputBindingFn      func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	sendToOutputBindingFn      func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	tracingSpec                config.TracingSpec
 	accessControlList          *config.AccessControlList
 	appProtocol                string
@@ -130,36 +134,29 @@ func NewAPI(
 	pubsubAdapter runtime_pubsub.Adapter,
 	directMessaging messaging.DirectMessaging,
 	actor actors.Actors,
-	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
+	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
 	tracingSpec config.TracingSpec,
 	accessControlList *config.AccessControlList,
 	appProtocol string,
 	getComponentsFn func() []components_v1alpha.Component,
 	shutdown func()) API {
-	transactionalStateStores := map[string]state.TransactionalStore{}
-	for key, store := range stateStores {
-		if state.FeatureTransactional.IsPresent(store.Features()) {
-			transactionalStateStores[key] = store.(state.TransactionalStore)
-		}
-	}
 
 	return &api{
-		directMessaging:          directMessaging,
-		actor:                    actor,
-		id:                       appID,
-		appChannel:               appChannel,
-		pubsubAdapter:            pubsubAdapter,
-		stateStores:              stateStores,
-		transactionalStateStores: transactionalStateStores,
-		secretStores:             secretStores,
-		configurationStores:      configurationStores,
-		secretsConfiguration:     secretsConfiguration,
-		sendToOutputBindingFn:    sendToOutputBindingFn,
-		tracingSpec:              tracingSpec,
-		accessControlList:        accessControlList,
-		appProtocol:              appProtocol,
-		shutdown:                 shutdown,
-		configurationSubscribe:   map[string]bool{},
+		directMessaging:        directMessaging,
+		actor:                  actor,
+		id:                     appID,
+		appChannel:             appChannel,
+		pubsubAdapter:          pubsubAdapter,
+		stateStores:            stateStores,
+		secretStores:           secretStores,
+		configurationStores:    configurationStores,
+		secretsConfiguration:   secretsConfiguration,
+		sendToOutputBindingFn:  sendToOutputBindingFn,
+		tracingSpec:            tracingSpec,
+		accessControlList:      accessControlList,
+		appProtocol:            appProtocol,
+		shutdown:               shutdown,
+		configurationSubscribe: map[string]bool{},
 	}
 }
 
@@ -297,7 +294,7 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 		Metadata:   in.Metadata,
 	}
 
-	err := a.pubsubAdapter.Publish(&req)
+	err := a.pubsubAdapter.Publish(ctx, &req)
 	if err != nil {
 		nerr := status.Errorf(codes.Internal, messages.ErrPubsubPublishMessage, topic, pubsubName, err.Error())
 		if errors.As(err, &runtime_pubsub.NotAllowedError{}) {
@@ -362,7 +359,7 @@ func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRe
 	}
 
 	r := &runtimev1pb.InvokeBindingResponse{}
-	resp, err := a.sendToOutputBindingFn(in.Name, req)
+	resp, err := a.sendToOutputBindingFn(ctx, in.Name, req)
 	if err != nil {
 		err = status.Errorf(codes.Internal, messages.ErrInvokeOutputBinding, in.Name, err.Error())
 		apiServerLogger.Debug(err)
@@ -377,7 +374,7 @@ func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRe
 }
 
 func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequest) (*runtimev1pb.GetBulkStateResponse, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetBulkStateResponse{}, err
@@ -466,7 +463,7 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 	return bulkResp, nil
 }
 
-func (a *api) getStateStore(name string) (state.Store, error) {
+func (a *api) getStateStore(ctx context.Context, name string) (state.Store, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		return nil, status.Error(codes.FailedPrecondition, messages.ErrStateStoresNotConfigured)
 	}
@@ -474,11 +471,11 @@ func (a *api) getStateStore(name string) (state.Store, error) {
 	if a.stateStores[name] == nil {
 		return nil, status.Errorf(codes.InvalidArgument, messages.ErrStateStoreNotFound, name)
 	}
-	return a.stateStores[name], nil
+	return state_sdk.WithContext(ctx, a.stateStores[name]), nil
 }
 
 func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetStateResponse{}, err
@@ -523,7 +520,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 }
 
 func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (*emptypb.Empty, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
@@ -576,7 +573,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 func (a *api) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryStateRequest) (*runtimev1pb.QueryStateResponse, error) {
 	ret := &runtimev1pb.QueryStateResponse{}
 
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return ret, err
@@ -644,7 +641,7 @@ func (a *api) stateErrorResponse(err error, format string, args ...interface{})
 }
 
 func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateRequest) (*emptypb.Empty, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
@@ -678,7 +675,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 }
 
 func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkStateRequest) (*empty.Empty, error) {
-	store, err := a.getStateStore(in.StoreName)
+	store, err := a.getStateStore(ctx, in.StoreName)
 	if err != nil {
 		apiServerLogger.Debug(err)
 		return &empty.Empty{}, err
@@ -739,7 +736,7 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 		Metadata: in.Metadata,
 	}
 
-	getResponse, err := a.secretStores[secretStoreName].GetSecret(req)
+	getResponse, err := secretstores_sdk.WithContext(ctx, a.secretStores[secretStoreName]).GetSecret(req)
 	if err != nil {
 		err = status.Errorf(codes.Internal, messages.ErrSecretGet, req.Name, secretStoreName, err.Error())
 		apiServerLogger.Debug(err)
@@ -772,7 +769,7 @@ func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRe
 		Metadata: in.Metadata,
 	}
 
-	getResponse, err := a.secretStores[secretStoreName].BulkGetSecret(req)
+	getResponse, err := secretstores_sdk.WithContext(ctx, a.secretStores[secretStoreName]).BulkGetSecret(req)
 	if err != nil {
 		err = status.Errorf(codes.Internal, messages.ErrBulkSecretGet, secretStoreName, err.Error())
 		apiServerLogger.Debug(err)
@@ -805,6 +802,17 @@ func extractEtag(req *commonv1pb.StateItem) (bool, string) {
 	return false, ""
 }
 
+// getTransactionalStore returns the state store as a transactional store when it supports transactions.
+// It is looked up at call time since the state stores served by a plugin are replaced when the plugin is updated.
+func (a *api) getTransactionalStore(storeName string) (state.TransactionalStore, bool) {
+	store, ok := a.stateStores[storeName]
+	if !ok || !state.FeatureTransactional.IsPresent(store.Features()) {
+		return nil, false
+	}
+	transactionalStore, ok := store.(state.TransactionalStore)
+	return transactionalStore, ok
+}
+
 func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteStateTransactionRequest) (*emptypb.Empty, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		err := status.Error(codes.FailedPrecondition, messages.ErrStateStoresNotConfigured)
@@ -820,12 +828,13 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		return &emptypb.Empty{}, err
 	}
 
-	transactionalStore, ok := a.transactionalStateStores[storeName]
+	transactionalStore, ok := a.getTransactionalStore(storeName)
 	if !ok {
 		err := status.Errorf(codes.Unimplemented, messages.ErrStateStoreNotSupported, storeName)
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
 	}
+	transactionalStore = state_sdk.WithTransactionalContext(ctx, transactionalStore)
 
 	operations := []state.TransactionalStateOperation{}
 	for _, inputReq := range in.Operations {
@@ -1022,6 +1031,109 @@ func (a *api) RenameActorReminder(ctx context.Context, in *runtimev1pb.RenameAct
 	return &emptypb.Empty{}, err
 }
 
+func (a *api) ListActorReminders(ctx context.Context, in *runtimev1pb.ListActorRemindersRequest) (*runtimev1pb.ListActorRemindersResponse, error) {
+	if a.actor == nil {
+		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
+		apiServerLogger.Debug(err)
+		return nil, err
+	}
+
+	resp, err := a.actor.ListReminders(ctx, &actors.ListRemindersRequest{
+		ActorType: in.ActorType,
+		ActorID:   in.ActorId,
+		PageSize:  int(in.PageSize),
+		PageToken: in.PageToken,
+	})
+	if err != nil {
+		err = status.Errorf(listErrorCode(err), messages.ErrActorReminderList, err)
+		apiServerLogger.Debug(err)
+		return nil, err
+	}
+
+	reminders := make([]*runtimev1pb.ActorReminder, len(resp.Reminders))
+	for i, r := range resp.Reminders {
+		data, err := encodeActorData(r.Data)
+		if err != nil {
+			err = status.Errorf(codes.Internal, messages.ErrActorReminderList, err)
+			apiServerLogger.Debug(err)
+			return nil, err
+		}
+		reminders[i] = &runtimev1pb.ActorReminder{
+			ActorType:      r.ActorType,
+			ActorId:        r.ActorID,
+			Name:           r.Name,
+			DueTime:        r.DueTime,
+			Period:         r.Period,
+			Data:           data,
+			RegisteredTime: r.RegisteredTime,
+			ExpirationTime: r.ExpirationTime,
+		}
+	}
+	return &runtimev1pb.ListActorRemindersResponse{
+		Reminders:     reminders,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (a *api) ListActorTimers(ctx context.Context, in *runtimev1pb.ListActorTimersRequest) (*runtimev1pb.ListActorTimersResponse, error) {
+	if a.actor == nil {
+		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
+		apiServerLogger.Debug(err)
+		return nil, err
+	}
+
+	resp, err := a.actor.ListTimers(ctx, &actors.ListTimersRequest{
+		ActorType: in.ActorType,
+		ActorID:   in.ActorId,
+		PageSize:  int(in.PageSize),
+		PageToken: in.PageToken,
+	})
+	if err != nil {
+		err = status.Errorf(listErrorCode(err), messages.ErrActorTimerList, err)
+		apiServerLogger.Debug(err)
+		return nil, err
+	}
+
+	timers := make([]*runtimev1pb.ActorTimer, len(resp.Timers))
+	for i, t := range resp.Timers {
+		data, err := encodeActorData(t.Data)
+		if err != nil {
+			err = status.Errorf(codes.Internal, messages.ErrActorTimerList, err)
+			apiServerLogger.Debug(err)
+			return nil, err
+		}
+		timers[i] = &runtimev1pb.ActorTimer{
+			ActorType: t.ActorType,
+			ActorId:   t.ActorID,
+			Name:      t.Name,
+			DueTime:   t.DueTime,
+			Period:    t.Period,
+			Callback:  t.Callback,
+			Data:      data,
+			Ttl:       t.TTL,
+		}
+	}
+	return &runtimev1pb.ListActorTimersResponse{
+		Timers:        timers,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// encodeActorData returns the JSON encoding of reminder or timer data, as the actors runtime delivers it to the actor.
+func encodeActorData(data interface{}) ([]byte, error) {
+	if data == nil {
+		return nil, nil
+	}
+	return json.Marshal(data)
+}
+
+func listErrorCode(err error) codes.Code {
+	if errors.Is(err, actors.ErrInvalidPageToken) {
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	if a.actor == nil {
 		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
@@ -46,6 +46,7 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/kit/logger"
 
+	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	"github.com/dapr/dapr/pkg/config"
@@ -1320,7 +1321,7 @@ func TestPublishTopic(t *testing.T) {
 
 	srv := &api{
 		pubsubAdapter: &daprt.MockPubSubAdapter{
-			PublishFn: func(req *pubsub.PublishRequest) error {
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
 				if req.Topic == "error-topic" {
 					return errors.New("error when publish")
 				}
@@ -1412,7 +1413,7 @@ func TestShutdownEndpoints(t *testing.T) {
 func TestInvokeBinding(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 	srv := &api{
-		sendToOutputBindingFn: func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+		sendToOutputBindingFn: func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
 			if name == "error-binding" {
 				return nil, errors.New("error when invoke binding")
 			}
@@ -1485,13 +1486,9 @@ func TestExecuteStateTransaction(t *testing.T) {
 		return matchKeyFn(req, "error-key")
 	})).Return(errors.New("error to execute with key2"))
 
-	var fakeTransactionalStore state.TransactionalStore = fakeStore
 	fakeAPI := &api{
 		id:          "fakeAPI",
 		stateStores: map[string]state.Store{"store1": fakeStore},
-		transactionalStateStores: map[string]state.TransactionalStore{
-			"store1": fakeTransactionalStore,
-		},
 	}
 	port, _ := freeport.GetFreePort()
 	server := startDaprAPIServer(port, fakeAPI, "")
@@ -1599,6 +1596,94 @@ func TestGetMetadata(t *testing.T) {
 	assert.Equal(t, response.ExtendedMetadata["testKey"], "testValue")
 }
 
+func TestListActorReminders(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	mockActors := new(daprt.MockActors)
+	mockActors.On("ListReminders", &actors.ListRemindersRequest{
+		ActorType: "fakeActorType",
+		PageSize:  1,
+	}).Return(&actors.ListRemindersResponse{
+		Reminders: []actors.Reminder{{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			Name:      "reminder1",
+			Period:    "CRON_TZ=Europe/Berlin 0 9 * * 1-5",
+			Data:      map[string]interface{}{"key": "value"},
+		}},
+		NextPageToken: "next",
+	}, nil)
+	mockActors.On("ListReminders", &actors.ListRemindersRequest{
+		ActorType: "fakeActorType",
+		PageToken: "invalid",
+	}).Return(nil, actors.ErrInvalidPageToken)
+	fakeAPI := &api{
+		id:    "fakeAPI",
+		actor: mockActors,
+	}
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+	t.Run("page of reminders", func(t *testing.T) {
+		response, err := client.ListActorReminders(context.Background(), &runtimev1pb.ListActorRemindersRequest{
+			ActorType: "fakeActorType",
+			PageSize:  1,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, response.Reminders, 1)
+		assert.Equal(t, "reminder1", response.Reminders[0].Name)
+		assert.Equal(t, "fakeActorID", response.Reminders[0].ActorId)
+		assert.Equal(t, `{"key":"value"}`, string(response.Reminders[0].Data))
+		assert.Equal(t, "next", response.NextPageToken)
+	})
+	t.Run("invalid page token", func(t *testing.T) {
+		_, err := client.ListActorReminders(context.Background(), &runtimev1pb.ListActorRemindersRequest{
+			ActorType: "fakeActorType",
+			PageToken: "invalid",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListActorTimers(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	mockActors := new(daprt.MockActors)
+	mockActors.On("ListTimers", &actors.ListTimersRequest{
+		ActorType: "fakeActorType",
+		ActorID:   "fakeActorID",
+	}).Return(&actors.ListTimersResponse{
+		Timers: []actors.Timer{{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			Name:      "timer1",
+			Callback:  "callback",
+		}},
+	}, nil)
+	fakeAPI := &api{
+		id:    "fakeAPI",
+		actor: mockActors,
+	}
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+	response, err := client.ListActorTimers(context.Background(), &runtimev1pb.ListActorTimersRequest{
+		ActorType: "fakeActorType",
+		ActorId:   "fakeActorID",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.Timers, 1)
+	assert.Equal(t, "callback", response.Timers[0].Callback)
+	assert.Nil(t, response.Timers[0].Data)
+	assert.Empty(t, response.NextPageToken)
+}
+
 func TestSetMetadata(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 	fakeComponent := components_v1alpha.Component{}
@@ -14,6 +14,7 @@ limitations under the License.
 package http
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"strconv"
@@ -48,6 +49,8 @@ import (
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	secretstores_sdk "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
+	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 )
 
 // API returns a list of HTTP endpoints for Dapr.
@@ -59,28 +62,29 @@ type API interface {
 	SetAppChannel(appChannel channel.AppChannel)
 	SetDirectMessaging(directMessaging messaging.DirectMessaging)
 	SetActorRuntime(actor actors.Actors)
+	SetPluginHealthFn(pluginHealthFn func() error)
 }
 
 type api struct {
-	endpoints                []Endpoint
-	publicEndpoints          []Endpoint
-	directMessaging          messaging.DirectMessaging
-	appChannel               channel.AppChannel
-	getComponentsFn          func() []components_v1alpha1.Component
-	stateStores              map[string]state.Store
-	transactionalStateStores map[string]state.TransactionalStore
-	secretStores             map[string]secretstores.SecretStore
-	secretsConfiguration     map[string]config.SecretsScope
-	json                     jsoniter.API
-	actor                    actors.Actors
-	pubsubAdapter            runtime_pubsub.Adapter
-	sendToOutputBindingFn    func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	id                       string
-	extendedMetadata         sync.Map
-	readyStatus              bool
-	outboundReadyStatus      bool
-	tracingSpec              config.TracingSpec
-	shutdown                 func()
+	endpoints             []Endpoint
+	publicEndpoints       []Endpoint
+	directMessaging       messaging.DirectMessaging
+	appChannel            channel.AppChannel
+	getComponentsFn       func() []components_v1alpha1.Component
+	stateStores           map[string]state.Store
+	secretStores          map[string]secretstores.SecretStore
+	secretsConfiguration  map[string]config.SecretsScope
+	json                  jsoniter.API
+	actor                 actors.Actors
+	pubsubAdapter         runtime_pubsub.Adapter
+	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	id                    string
+	extendedMetadata      sync.Map
+	readyStatus           bool
+	pluginHealthFn        func() error
+	outboundReadyStatus   bool
+	tracingSpec           config.TracingSpec
+	shutdown              func()
 }
 
 type registeredComponent struct {
@@ -111,6 +115,8 @@ const (
 	nameParam            = "name"
 	consistencyParam     = "consistency"
 	concurrencyParam     = "concurrency"
+	pageSizeParam        = "pageSize"
+	pageTokenParam       = "pageToken"
 	pubsubnameparam      = "pubsubname"
 	traceparentHeader    = "traceparent"
 	tracestateHeader     = "tracestate"
@@ -128,30 +134,23 @@ func NewAPI(
 	secretsConfiguration map[string]config.SecretsScope,
 	pubsubAdapter runtime_pubsub.Adapter,
 	actor actors.Actors,
-	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
+	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
 	tracingSpec config.TracingSpec,
 	shutdown func()) API {
-	transactionalStateStores := map[string]state.TransactionalStore{}
-	for key, store := range stateStores {
-		if state.FeatureTransactional.IsPresent(store.Features()) {
-			transactionalStateStores[key] = store.(state.TransactionalStore)
-		}
-	}
 	api := &api{
-		appChannel:               appChannel,
-		getComponentsFn:          getComponentsFn,
-		directMessaging:          directMessaging,
-		stateStores:              stateStores,
-		transactionalStateStores: transactionalStateStores,
-		secretStores:             secretStores,
-		secretsConfiguration:     secretsConfiguration,
-		json:                     jsoniter.ConfigFastest,
-		actor:                    actor,
-		pubsubAdapter:            pubsubAdapter,
-		sendToOutputBindingFn:    sendToOutputBindingFn,
-		id:                       appID,
-		tracingSpec:              tracingSpec,
-		shutdown:                 shutdown,
+		appChannel:            appChannel,
+		getComponentsFn:       getComponentsFn,
+		directMessaging:       directMessaging,
+		stateStores:           stateStores,
+		secretStores:          secretStores,
+		secretsConfiguration:  secretsConfiguration,
+		json:                  jsoniter.ConfigFastest,
+		actor:                 actor,
+		pubsubAdapter:         pubsubAdapter,
+		sendToOutputBindingFn: sendToOutputBindingFn,
+		id:                    appID,
+		tracingSpec:           tracingSpec,
+		shutdown:              shutdown,
 	}
 
 	metadataEndpoints := api.constructMetadataEndpoints()
@@ -286,7 +285,21 @@ func (a *api) constructDirectMessagingEndpoints() []Endpoint {
 }
 
 func (a *api) constructActorEndpoints() []Endpoint {
-	return []Endpoint{
+	endpoints := []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onListActorReminders,
+		},
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/timers",
+			Version: apiVersionV1,
+			Handler: a.onListActorTimers,
+		},
+	}
+	actorEndpoints := []Endpoint{
 		{
 			Methods: []string{fasthttp.MethodPost, fasthttp.MethodPut},
 			Route:   "actors/{actorType}/{actorId}/state",
@@ -341,7 +354,39 @@ func (a *api) constructActorEndpoints() []Endpoint {
 			Version: apiVersionV1,
 			Handler: a.onRenameActorReminder,
 		},
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/{actorId}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onListActorReminders,
+		},
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/{actorId}/timers",
+			Version: apiVersionV1,
+			Handler: a.onListActorTimers,
+		},
+	}
+
+	// the router does not fall back from the static segment of the actor type wide lists to the {actorId} parameter,
+	// so the routes of the actors with ID reminders or timers are registered with the literal ID
+	for _, actorID := range []string{"reminders", "timers"} {
+		for _, e := range actorEndpoints {
+			endpoints = append(endpoints, literalActorIDEndpoint(e, actorID))
+		}
 	}
+	return append(endpoints, actorEndpoints...)
+}
+
+// literalActorIDEndpoint returns the endpoint routed for the given actor ID instead of the {actorId} parameter.
+func literalActorIDEndpoint(e Endpoint, actorID string) Endpoint {
+	handler := e.Handler
+	e.Route = strings.Replace(e.Route, "{"+actorIDParam+"}", actorID, 1)
+	e.Handler = func(reqCtx *fasthttp.RequestCtx) {
+		reqCtx.SetUserValue(actorIDParam, actorID)
+		handler(reqCtx)
+	}
+	return e
 }
 
 func (a *api) constructMetadataEndpoints() []Endpoint {
@@ -425,7 +470,7 @@ func (a *api) onOutputBindingMessage(reqCtx *fasthttp.RequestCtx) {
 		}
 	}
 
-	resp, err := a.sendToOutputBindingFn(name, &bindings.InvokeRequest{
+	resp, err := a.sendToOutputBindingFn(reqCtx, name, &bindings.InvokeRequest{
 		Metadata:  req.Metadata,
 		Data:      b,
 		Operation: bindings.OperationKind(req.Operation),
@@ -575,7 +620,7 @@ func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (s
 		log.Debug(msg)
 		return nil, "", errors.New(msg.Message)
 	}
-	return a.stateStores[storeName], storeName, nil
+	return state_sdk.WithContext(reqCtx, a.stateStores[storeName]), storeName, nil
 }
 
 func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
@@ -788,7 +833,7 @@ func (a *api) getSecretStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (
 		respond(reqCtx, withError(fasthttp.StatusUnauthorized, msg))
 		return nil, "", errors.New(msg.Message)
 	}
-	return a.secretStores[secretStoreName], secretStoreName, nil
+	return secretstores_sdk.WithContext(reqCtx, a.secretStores[secretStoreName]), secretStoreName, nil
 }
 
 func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
@@ -1187,6 +1232,103 @@ func (a *api) onGetActorReminder(reqCtx *fasthttp.RequestCtx) {
 	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
 }
 
+func (a *api) onListActorReminders(reqCtx *fasthttp.RequestCtx) {
+	if a.actor == nil {
+		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	pageSize, ok := getPageSize(reqCtx)
+	if !ok {
+		return
+	}
+	// the actor ID is not set when the reminders of the whole actor type are listed
+	actorID, _ := reqCtx.UserValue(actorIDParam).(string)
+	resp, err := a.actor.ListReminders(reqCtx, &actors.ListRemindersRequest{
+		ActorType: reqCtx.UserValue(actorTypeParam).(string),
+		ActorID:   actorID,
+		PageSize:  pageSize,
+		PageToken: string(reqCtx.QueryArgs().Peek(pageTokenParam)),
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDER_LIST", fmt.Sprintf(messages.ErrActorReminderList, err))
+		respond(reqCtx, withError(listErrorStatusCode(err), msg))
+		log.Debug(msg)
+		return
+	}
+	b, err := a.json.Marshal(resp)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDER_LIST", fmt.Sprintf(messages.ErrActorReminderList, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
+}
+
+func (a *api) onListActorTimers(reqCtx *fasthttp.RequestCtx) {
+	if a.actor == nil {
+		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	pageSize, ok := getPageSize(reqCtx)
+	if !ok {
+		return
+	}
+	// the actor ID is not set when the timers of the whole actor type are listed
+	actorID, _ := reqCtx.UserValue(actorIDParam).(string)
+	resp, err := a.actor.ListTimers(reqCtx, &actors.ListTimersRequest{
+		ActorType: reqCtx.UserValue(actorTypeParam).(string),
+		ActorID:   actorID,
+		PageSize:  pageSize,
+		PageToken: string(reqCtx.QueryArgs().Peek(pageTokenParam)),
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_TIMER_LIST", fmt.Sprintf(messages.ErrActorTimerList, err))
+		respond(reqCtx, withError(listErrorStatusCode(err), msg))
+		log.Debug(msg)
+		return
+	}
+	b, err := a.json.Marshal(resp)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_TIMER_LIST", fmt.Sprintf(messages.ErrActorTimerList, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
+}
+
+// getPageSize returns the page size query parameter of a list request, zero when not set. The request is answered with an error when the page size is invalid.
+func getPageSize(reqCtx *fasthttp.RequestCtx) (int, bool) {
+	pageSize := string(reqCtx.QueryArgs().Peek(pageSizeParam))
+	if pageSize == "" {
+		return 0, true
+	}
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size < 0 {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrActorListPageSize, pageSize))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return 0, false
+	}
+	return size, true
+}
+
+func listErrorStatusCode(err error) int {
+	if errors.Is(err, actors.ErrInvalidPageToken) {
+		return fasthttp.StatusBadRequest
+	}
+	return fasthttp.StatusInternalServerError
+}
+
 func (a *api) onDeleteActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
@@ -1465,7 +1607,7 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 		Metadata:   metadata,
 	}
 
-	err := a.pubsubAdapter.Publish(&req)
+	err := a.pubsubAdapter.Publish(reqCtx, &req)
 	if err != nil {
 		status := fasthttp.StatusInternalServerError
 		msg := NewErrorResponse("ERR_PUBSUB_PUBLISH_MESSAGE",
@@ -1506,6 +1648,10 @@ func (a *api) onGetHealthz(reqCtx *fasthttp.RequestCtx) {
 		msg := NewErrorResponse("ERR_HEALTH_NOT_READY", messages.ErrHealthNotReady)
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		log.Debug(msg)
+	} else if err := a.pluginHealth(); err != nil {
+		msg := NewErrorResponse("ERR_HEALTH_PLUGIN_DOWN", fmt.Sprintf(messages.ErrHealthPluginDown, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
 	} else {
 		respond(reqCtx, withEmpty())
 	}
@@ -1534,6 +1680,17 @@ func getMetadataFromRequest(reqCtx *fasthttp.RequestCtx) map[string]string {
 	return metadata
 }
 
+// getTransactionalStore returns the state store as a transactional store when it supports transactions.
+// It is looked up at call time since the state stores served by a plugin are replaced when the plugin is updated.
+func (a *api) getTransactionalStore(storeName string) (state.TransactionalStore, bool) {
+	store, ok := a.stateStores[storeName]
+	if !ok || !state.FeatureTransactional.IsPresent(store.Features()) {
+		return nil, false
+	}
+	transactionalStore, ok := store.(state.TransactionalStore)
+	return transactionalStore, ok
+}
+
 func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		msg := NewErrorResponse("ERR_STATE_STORES_NOT_CONFIGURED", messages.ErrStateStoresNotConfigured)
@@ -1551,13 +1708,14 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		return
 	}
 
-	transactionalStore, ok := a.transactionalStateStores[storeName]
+	transactionalStore, ok := a.getTransactionalStore(storeName)
 	if !ok {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", fmt.Sprintf(messages.ErrStateStoreNotSupported, storeName))
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		log.Debug(msg)
 		return
 	}
+	transactionalStore = state_sdk.WithTransactionalContext(reqCtx, transactionalStore)
 
 	body := reqCtx.PostBody()
 	var req state.TransactionalStateRequest
@@ -1740,3 +1898,14 @@ func (a *api) SetDirectMessaging(directMessaging messaging.DirectMessaging) {
 func (a *api) SetActorRuntime(actor actors.Actors) {
 	a.actor = actor
 }
+
+func (a *api) SetPluginHealthFn(pluginHealthFn func() error) {
+	a.pluginHealthFn = pluginHealthFn
+}
+
+func (a *api) pluginHealth() error {
+	if a.pluginHealthFn == nil {
+		return nil
+	}
+	return a.pluginHealthFn()
+}
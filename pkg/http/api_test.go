@@ -69,7 +69,7 @@ func TestPubSubEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
 		pubsubAdapter: &daprt.MockPubSubAdapter{
-			PublishFn: func(req *pubsub.PublishRequest) error {
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
 				if req.PubsubName == "errorpubsub" {
 					return fmt.Errorf("Error from pubsub %s", req.PubsubName)
 				}
@@ -290,7 +290,7 @@ func TestGetMetadataFromRequest(t *testing.T) {
 func TestV1OutputBindingsEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
-		sendToOutputBindingFn: func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+		sendToOutputBindingFn: func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
 			if name == "testbinding" {
 				return nil, nil
 			}
@@ -353,7 +353,7 @@ func TestV1OutputBindingsEndpoints(t *testing.T) {
 		}
 		b, _ := json.Marshal(&req)
 
-		testAPI.sendToOutputBindingFn = func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+		testAPI.sendToOutputBindingFn = func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
 			return nil, errors.New("missing binding name")
 		}
 
@@ -379,9 +379,11 @@ func TestV1OutputBindingsEndpointsWithTracer(t *testing.T) {
 	createExporters(&buffer)
 
 	testAPI := &api{
-		sendToOutputBindingFn: func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) { return nil, nil },
-		json:                  jsoniter.ConfigFastest,
-		tracingSpec:           spec,
+		sendToOutputBindingFn: func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+			return nil, nil
+		},
+		json:        jsoniter.ConfigFastest,
+		tracingSpec: spec,
 	}
 	fakeServer.StartServerWithTracing(spec, testAPI.constructBindingsEndpoints())
 
@@ -410,7 +412,7 @@ func TestV1OutputBindingsEndpointsWithTracer(t *testing.T) {
 		}
 		b, _ := json.Marshal(&req)
 
-		testAPI.sendToOutputBindingFn = func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+		testAPI.sendToOutputBindingFn = func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
 			return nil, errors.New("missing binding name")
 		}
 
@@ -867,6 +869,10 @@ func TestV1ActorEndpoints(t *testing.T) {
 			"v1.0/actors/fakeActorType/fakeActorID/reminders/reminder1": {"POST", "PUT", "GET", "DELETE", "PATCH"},
 			"v1.0/actors/fakeActorType/fakeActorID/method/method1":      {"POST", "PUT", "GET", "DELETE"},
 			"v1.0/actors/fakeActorType/fakeActorID/timers/timer1":       {"POST", "PUT", "DELETE"},
+			"v1.0/actors/fakeActorType/fakeActorID/reminders":           {"GET"},
+			"v1.0/actors/fakeActorType/fakeActorID/timers":              {"GET"},
+			"v1.0/actors/fakeActorType/reminders":                       {"GET"},
+			"v1.0/actors/fakeActorType/timers":                          {"GET"},
 		}
 		testAPI.actor = nil
 
@@ -1338,6 +1344,162 @@ func TestV1ActorEndpoints(t *testing.T) {
 		mockActors.AssertNumberOfCalls(t, "GetReminder", 1)
 	})
 
+	t.Run("Reminders List - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		listRequest := actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			PageSize:  10,
+			PageToken: "token",
+		}
+		listResponse := &actors.ListRemindersResponse{
+			Reminders:     []actors.Reminder{{ActorType: "fakeActorType", ActorID: "fakeActorID", Name: "reminder1", Period: "1s"}},
+			NextPageToken: "next",
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListReminders", &listRequest).Return(listResponse, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"pageSize": "10", "pageToken": "token"})
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var body actors.ListRemindersResponse
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, "reminder1", body.Reminders[0].Name)
+		assert.Equal(t, "next", body.NextPageToken)
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 1)
+	})
+
+	t.Run("Reminders List of an actor type - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+		listRequest := actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListReminders", &listRequest).Return(&actors.ListRemindersResponse{Reminders: []actors.Reminder{}}, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 1)
+	})
+
+	t.Run("Reminders List of an actor with ID reminders - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders/reminders"
+		listRequest := actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "reminders",
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListReminders", &listRequest).Return(&actors.ListRemindersResponse{Reminders: []actors.Reminder{}}, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 1)
+	})
+
+	t.Run("Reminders List - 400 on invalid page size", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		mockActors := new(daprt.MockActors)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"pageSize": "-1"})
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 0)
+	})
+
+	t.Run("Reminders List - 400 on invalid page token", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		listRequest := actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			PageToken: "invalid",
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListReminders", &listRequest).Return(nil, actors.ErrInvalidPageToken)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"pageToken": "invalid"})
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDER_LIST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Timers List - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/timers"
+		listRequest := actors.ListTimersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+		}
+		listResponse := &actors.ListTimersResponse{
+			Timers: []actors.Timer{{ActorType: "fakeActorType", ActorID: "fakeActorID", Name: "timer1", Callback: "callback"}},
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListTimers", &listRequest).Return(listResponse, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var body actors.ListTimersResponse
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, "timer1", body.Timers[0].Name)
+		mockActors.AssertNumberOfCalls(t, "ListTimers", 1)
+	})
+
+	t.Run("Timers List of an actor type - 500 on upstream actor error", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/timers"
+		listRequest := actors.ListTimersRequest{
+			ActorType: "fakeActorType",
+		}
+
+		mockActors := new(daprt.MockActors)
+
+		mockActors.On("ListTimers", &listRequest).Return(nil, errors.New("UPSTREAM_ERROR"))
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_TIMER_LIST", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Reminder Get - 500 on upstream actor error", func(t *testing.T) {
 		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders/reminder1"
 		reminderRequest := actors.GetReminderRequest{
@@ -2296,13 +2458,9 @@ func TestV1StateEndpoints(t *testing.T) {
 	fakeStores := map[string]state.Store{
 		"store1": fakeStore,
 	}
-	fakeTransactionalStores := map[string]state.TransactionalStore{
-		"store1": fakeStore.(state.TransactionalStore),
-	}
 	testAPI := &api{
-		stateStores:              fakeStores,
-		transactionalStateStores: fakeTransactionalStores,
-		json:                     jsoniter.ConfigFastest,
+		stateStores: fakeStores,
+		json:        jsoniter.ConfigFastest,
 	}
 	fakeServer.StartServer(testAPI.constructStateEndpoints())
 	storeName := "store1"
@@ -2951,13 +3109,9 @@ func TestV1TransactionEndpoints(t *testing.T) {
 		"store1":                fakeStore,
 		"storeNonTransactional": fakeStoreNonTransactional,
 	}
-	fakeTransactionalStores := map[string]state.TransactionalStore{
-		"store1": fakeStore.(state.TransactionalStore),
-	}
 	testAPI := &api{
-		stateStores:              fakeStores,
-		transactionalStateStores: fakeTransactionalStores,
-		json:                     jsoniter.ConfigFastest,
+		stateStores: fakeStores,
+		json:        jsoniter.ConfigFastest,
 	}
 	fakeServer.StartServer(testAPI.constructStateEndpoints())
 	fakeBodyObject := map[string]interface{}{"data": "fakeData"}
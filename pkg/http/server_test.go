@@ -262,6 +262,47 @@ func TestAllowedAPISpec(t *testing.T) {
 		}
 	})
 
+	t.Run("actors allowed", func(t *testing.T) {
+		s := server{
+			apiSpec: config.APISpec{
+				Allowed: []config.APIAccessRule{
+					{
+						Name:     "actors",
+						Version:  "v1.0",
+						Protocol: "http",
+					},
+				},
+			},
+		}
+
+		a := &api{}
+		eps := a.constructActorEndpoints()
+
+		for _, e := range eps {
+			valid := s.endpointAllowed(e)
+			assert.True(t, valid)
+		}
+
+		allOtherEndpoints := []Endpoint{}
+		allOtherEndpoints = append(allOtherEndpoints, a.constructMetadataEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructDirectMessagingEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructPubSubEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructBindingsEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructStateEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructSecretEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructShutdownEndpoints()...)
+		allOtherEndpoints = append(allOtherEndpoints, a.constructHealthzEndpoints()...)
+
+		for _, e := range allOtherEndpoints {
+			valid := s.endpointAllowed(e)
+			if e.Route == healthzEndpoint {
+				assert.True(t, valid)
+			} else {
+				assert.False(t, valid)
+			}
+		}
+	})
+
 	t.Run("secrets allowed", func(t *testing.T) {
 		s := server{
 			apiSpec: config.APISpec{
@@ -74,6 +74,7 @@ const (
 	daprReadBufferSize                = "dapr.io/http-read-buffer-size"
 	daprHTTPStreamRequestBody         = "dapr.io/http-stream-request-body"
 	daprGracefulShutdownSeconds       = "dapr.io/graceful-shutdown-seconds"
+	daprPluginsKey                    = "dapr.io/plugins"
 	containersPath                    = "/spec/containers"
 	sidecarHTTPPort                   = 3500
 	sidecarAPIGRPCPort                = 50001
@@ -111,6 +112,13 @@ const (
 	defaultMtlsEnabled                = true
 	trueString                        = "true"
 	defaultDaprHTTPStreamRequestBody  = false
+	pluginContainerNamePrefix         = "dapr-plugin-"
+	pluginAddress                     = "127.0.0.1"
+	pluginPortStart                   = 50100
+	// pluginPortEnvVar holds the port of a plugin container, see sdk.PortEnvVar
+	pluginPortEnvVar = "DAPR_PLUGIN_PORT"
+	// pluginEnvPrefix names the discovery env vars of daprd, kept apart from pluginPortEnvVar for a plugin named port
+	pluginEnvPrefix = "DAPR_PLUGIN_ENDPOINT_"
 )
 
 func (i *injector) getPodPatchOperations(ar *v1.AdmissionReview,
@@ -164,27 +172,35 @@ func (i *injector) getPodPatchOperations(ar *v1.AdmissionReview,
 		return nil, err
 	}
 
+	pluginContainers, pluginEnv, err := getPluginContainers(pod.Annotations, req.Namespace, imagePullPolicy, daprClient, getContainerPorts(append(pod.Spec.Containers, *sidecarContainer)))
+	if err != nil {
+		return nil, err
+	}
+	sidecarContainer.Env = append(sidecarContainer.Env, pluginEnv...)
+
 	patchOps := []PatchOperation{}
 	envPatchOps := []PatchOperation{}
-	var path string
-	var value interface{}
 	if len(pod.Spec.Containers) == 0 {
-		path = containersPath
-		value = []corev1.Container{*sidecarContainer}
+		patchOps = append(patchOps, PatchOperation{
+			Op:    "add",
+			Path:  containersPath,
+			Value: append([]corev1.Container{*sidecarContainer}, pluginContainers...),
+		})
 	} else {
 		envPatchOps = addDaprEnvVarsToContainers(pod.Spec.Containers)
-		path = "/spec/containers/-"
-		value = sidecarContainer
-	}
-
-	patchOps = append(
-		patchOps,
-		PatchOperation{
+		patchOps = append(patchOps, PatchOperation{
 			Op:    "add",
-			Path:  path,
-			Value: value,
-		},
-	)
+			Path:  "/spec/containers/-",
+			Value: sidecarContainer,
+		})
+		for i := range pluginContainers {
+			patchOps = append(patchOps, PatchOperation{
+				Op:    "add",
+				Path:  "/spec/containers/-",
+				Value: &pluginContainers[i],
+			})
+		}
+	}
 	patchOps = append(patchOps, envPatchOps...)
 
 	return patchOps, nil
@@ -246,6 +262,94 @@ LoopEnv:
 	return patchOps
 }
 
+// getPluginContainers builds a container for each plugin listed in the plugins annotation.
+// Every plugin gets its own port, skipping the ports already used in the pod, and the returned env vars let daprd discover it on localhost.
+// The port is passed to the plugin both as the -p argument and as the DAPR_PLUGIN_PORT env var.
+func getPluginContainers(annotations map[string]string, namespace, imagePullPolicy string, daprClient scheme.Interface, usedPorts map[int32]bool) ([]corev1.Container, []corev1.EnvVar, error) {
+	names := getPluginNames(annotations)
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+
+	containers := make([]corev1.Container, 0, len(names))
+	envs := make([]corev1.EnvVar, 0, len(names))
+	port := int32(pluginPortStart)
+	for _, name := range names {
+		p, err := daprClient.PluginsV1alpha1().Plugins(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not get plugin %s", name)
+		}
+		if p.Spec.Container == nil || p.Spec.Container.Repository == "" {
+			return nil, nil, errors.Errorf("plugin %s does not define a container", name)
+		}
+
+		for usedPorts[port] {
+			port++
+		}
+		usedPorts[port] = true
+		image := p.Spec.Container.Repository
+		if p.Spec.Container.Tag != "" {
+			image = fmt.Sprintf("%s:%s", image, p.Spec.Container.Tag)
+		}
+		containers = append(containers, corev1.Container{
+			Name:            pluginContainerNamePrefix + name,
+			Image:           image,
+			ImagePullPolicy: getPullPolicy(imagePullPolicy),
+			Args:            []string{"-p", strconv.Itoa(int(port))},
+			Env: []corev1.EnvVar{
+				{
+					Name:  pluginPortEnvVar,
+					Value: strconv.Itoa(int(port)),
+				},
+			},
+			Ports: []corev1.ContainerPort{
+				{
+					ContainerPort: port,
+				},
+			},
+		})
+
+		// The plugin is looked up by the same name and version daprd derives from the plugin resource.
+		pluginName, version := name, p.Spec.Container.Tag
+		if p.Spec.Run != nil {
+			if p.Spec.Run.Name != "" {
+				pluginName = p.Spec.Run.Name
+			}
+			version = p.Spec.Run.Version
+		}
+		envs = append(envs, corev1.EnvVar{
+			Name:  getPluginEnvName(name),
+			Value: fmt.Sprintf("name: %s|version: %s|address: %s|port: %d", pluginName, version, pluginAddress, port),
+		})
+	}
+	return containers, envs, nil
+}
+
+// getContainerPorts returns the ports used by the containers.
+func getContainerPorts(containers []corev1.Container) map[int32]bool {
+	ports := map[int32]bool{}
+	for _, c := range containers {
+		for _, p := range c.Ports {
+			ports[p.ContainerPort] = true
+		}
+	}
+	return ports
+}
+
+func getPluginNames(annotations map[string]string) []string {
+	var names []string
+	for _, name := range strings.Split(getStringAnnotation(annotations, daprPluginsKey), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func getPluginEnvName(name string) string {
+	return pluginEnvPrefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+}
+
 func getTrustAnchorsAndCertChain(kubeClient kubernetes.Interface, namespace string) (string, string, string) {
 	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), certs.KubeScrtName, meta_v1.GetOptions{})
 	if err != nil {
@@ -21,8 +21,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
+	"github.com/dapr/dapr/pkg/client/clientset/versioned/fake"
 )
 
 const defaultTestConfig = "config"
@@ -463,3 +467,114 @@ func TestAddDaprEnvVarsToContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPluginContainers(t *testing.T) {
+	daprClient := fake.NewSimpleClientset(
+		&plugins_v1alpha1.Plugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "my-store", Namespace: "default"},
+			Spec: plugins_v1alpha1.PluginSpec{
+				Type:      "GRPC",
+				Container: &plugins_v1alpha1.Container{Repository: "gomemory", Tag: "v1"},
+			},
+		},
+		&plugins_v1alpha1.Plugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "pubsub", Namespace: "default"},
+			Spec: plugins_v1alpha1.PluginSpec{
+				Type:      "GRPC",
+				Container: &plugins_v1alpha1.Container{Repository: "registry/pubsub", Tag: "latest"},
+				Run:       &plugins_v1alpha1.Run{Name: "mypubsub", Version: "v2"},
+			},
+		},
+		&plugins_v1alpha1.Plugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "port", Namespace: "default"},
+			Spec: plugins_v1alpha1.PluginSpec{
+				Type:      "GRPC",
+				Container: &plugins_v1alpha1.Container{Repository: "registry/port", Tag: "v1"},
+			},
+		},
+		&plugins_v1alpha1.Plugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "nocontainer", Namespace: "default"},
+			Spec: plugins_v1alpha1.PluginSpec{
+				Type: "GRPC",
+			},
+		},
+	)
+
+	t.Run("no plugins annotation", func(t *testing.T) {
+		containers, envs, err := getPluginContainers(map[string]string{}, "default", "", daprClient, map[int32]bool{})
+		assert.NoError(t, err)
+		assert.Empty(t, containers)
+		assert.Empty(t, envs)
+	})
+
+	t.Run("containers and discovery env for each plugin", func(t *testing.T) {
+		annotations := map[string]string{daprPluginsKey: "my-store, pubsub"}
+		containers, envs, err := getPluginContainers(annotations, "default", "Always", daprClient, map[int32]bool{})
+		assert.NoError(t, err)
+		assert.Len(t, containers, 2)
+
+		assert.Equal(t, "dapr-plugin-my-store", containers[0].Name)
+		assert.Equal(t, "gomemory:v1", containers[0].Image)
+		assert.Equal(t, corev1.PullAlways, containers[0].ImagePullPolicy)
+		assert.Equal(t, []string{"-p", "50100"}, containers[0].Args)
+		assert.Equal(t, []corev1.EnvVar{{Name: "DAPR_PLUGIN_PORT", Value: "50100"}}, containers[0].Env)
+		assert.Equal(t, int32(50100), containers[0].Ports[0].ContainerPort)
+
+		assert.Equal(t, "dapr-plugin-pubsub", containers[1].Name)
+		assert.Equal(t, "registry/pubsub:latest", containers[1].Image)
+		assert.Equal(t, []string{"-p", "50101"}, containers[1].Args)
+
+		assert.Equal(t, []corev1.EnvVar{
+			{
+				Name:  "DAPR_PLUGIN_ENDPOINT_MY_STORE",
+				Value: "name: my-store|version: v1|address: 127.0.0.1|port: 50100",
+			},
+			{
+				Name:  "DAPR_PLUGIN_ENDPOINT_PUBSUB",
+				Value: "name: mypubsub|version: v2|address: 127.0.0.1|port: 50101",
+			},
+		}, envs)
+	})
+
+	t.Run("ports used in the pod are skipped", func(t *testing.T) {
+		annotations := map[string]string{daprPluginsKey: "my-store, pubsub"}
+		usedPorts := getContainerPorts([]corev1.Container{
+			{
+				Name:  "app",
+				Ports: []corev1.ContainerPort{{ContainerPort: 50100}, {ContainerPort: 50102}},
+			},
+		})
+		containers, _, err := getPluginContainers(annotations, "default", "", daprClient, usedPorts)
+		assert.NoError(t, err)
+		assert.Len(t, containers, 2)
+		assert.Equal(t, int32(50101), containers[0].Ports[0].ContainerPort)
+		assert.Equal(t, int32(50103), containers[1].Ports[0].ContainerPort)
+	})
+
+	t.Run("plugin named port", func(t *testing.T) {
+		annotations := map[string]string{daprPluginsKey: "port"}
+		containers, envs, err := getPluginContainers(annotations, "default", "", daprClient, map[int32]bool{})
+		assert.NoError(t, err)
+		assert.Len(t, containers, 1)
+		// the discovery env var of daprd does not collide with the port env var of the plugin container
+		assert.Equal(t, []corev1.EnvVar{{Name: "DAPR_PLUGIN_PORT", Value: "50100"}}, containers[0].Env)
+		assert.Equal(t, []corev1.EnvVar{
+			{
+				Name:  "DAPR_PLUGIN_ENDPOINT_PORT",
+				Value: "name: port|version: v1|address: 127.0.0.1|port: 50100",
+			},
+		}, envs)
+	})
+
+	t.Run("plugin not found", func(t *testing.T) {
+		annotations := map[string]string{daprPluginsKey: "missing"}
+		_, _, err := getPluginContainers(annotations, "default", "", daprClient, map[int32]bool{})
+		assert.Error(t, err)
+	})
+
+	t.Run("plugin without container", func(t *testing.T) {
+		annotations := map[string]string{daprPluginsKey: "nocontainer"}
+		_, _, err := getPluginContainers(annotations, "default", "", daprClient, map[int32]bool{})
+		assert.Error(t, err)
+	})
+}
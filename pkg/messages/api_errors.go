@@ -45,8 +45,11 @@ const (
 	ErrActorReminderRename       = "error rename actor reminder: %s"
 	ErrActorReminderGet          = "error getting actor reminder: %s"
 	ErrActorReminderDelete       = "error deleting actor reminder: %s"
+	ErrActorReminderList         = "error listing actor reminders: %s"
 	ErrActorTimerCreate          = "error creating actor timer: %s"
 	ErrActorTimerDelete          = "error deleting actor timer: %s"
+	ErrActorTimerList            = "error listing actor timers: %s"
+	ErrActorListPageSize         = "invalid page size %q"
 	ErrActorStateGet             = "error getting actor state: %s"
 	ErrActorStateTransactionSave = "error saving actor transaction state: %s"
 
@@ -67,7 +70,8 @@ const (
 	ErrMetadataGet = "failed deserializing metadata: %s"
 
 	// Healthz.
-	ErrHealthNotReady = "dapr is not ready"
+	ErrHealthNotReady   = "dapr is not ready"
+	ErrHealthPluginDown = "plugin is down: %s"
 
 	// Configuration.
 	ErrConfigurationStoresNotConfigured = "error configuration stores not configured"
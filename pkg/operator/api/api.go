@@ -38,6 +38,7 @@ import (
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	pluginsapi "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	subscriptionsapi_v2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	dapr_credentials "github.com/dapr/dapr/pkg/credentials"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
@@ -57,21 +58,24 @@ var log = logger.NewLogger("dapr.operator.api")
 type Server interface {
 	Run(certChain *dapr_credentials.CertChain)
 	OnComponentUpdated(component *componentsapi.Component)
+	OnPluginUpdated(plugin *pluginsapi.Plugin)
 }
 
 type apiServer struct {
 	operatorv1pb.UnimplementedOperatorServer
 	Client client.Client
 	// notify all dapr runtime
-	connLock          sync.Mutex
-	allConnUpdateChan map[string]chan *componentsapi.Component
+	connLock                sync.Mutex
+	allConnUpdateChan       map[string]chan *componentsapi.Component
+	allPluginConnUpdateChan map[string]chan *pluginsapi.Plugin
 }
 
 // NewAPIServer returns a new API server.
 func NewAPIServer(client client.Client) Server {
 	return &apiServer{
-		Client:            client,
-		allConnUpdateChan: make(map[string]chan *componentsapi.Component),
+		Client:                  client,
+		allConnUpdateChan:       make(map[string]chan *componentsapi.Component),
+		allPluginConnUpdateChan: make(map[string]chan *pluginsapi.Plugin),
 	}
 }
 
@@ -103,6 +107,14 @@ func (a *apiServer) OnComponentUpdated(component *componentsapi.Component) {
 	a.connLock.Unlock()
 }
 
+func (a *apiServer) OnPluginUpdated(plugin *pluginsapi.Plugin) {
+	a.connLock.Lock()
+	for _, connUpdateChan := range a.allPluginConnUpdateChan {
+		connUpdateChan <- plugin
+	}
+	a.connLock.Unlock()
+}
+
 // GetConfiguration returns a Dapr configuration.
 func (a *apiServer) GetConfiguration(ctx context.Context, in *operatorv1pb.GetConfigurationRequest) (*operatorv1pb.GetConfigurationResponse, error) {
 	key := types.NamespacedName{Namespace: in.Namespace, Name: in.Name}
@@ -186,6 +198,74 @@ func processComponentSecrets(component *componentsapi.Component, namespace strin
 	return nil
 }
 
+// processPluginSecrets populates the metadata secrets of the plugin from the Kubernetes secret store, like processComponentSecrets.
+func processPluginSecrets(plugin *pluginsapi.Plugin, namespace string, kubeClient client.Client) error {
+	for i, m := range plugin.Spec.Metadata {
+		if m.SecretKeyRef.Name != "" && (plugin.Auth.SecretStore == kubernetesSecretStore || plugin.Auth.SecretStore == "") {
+			var secret corev1.Secret
+
+			err := kubeClient.Get(context.TODO(), types.NamespacedName{
+				Name:      m.SecretKeyRef.Name,
+				Namespace: namespace,
+			}, &secret)
+			if err != nil {
+				return err
+			}
+
+			key := m.SecretKeyRef.Key
+			if key == "" {
+				key = m.SecretKeyRef.Name
+			}
+
+			val, ok := secret.Data[key]
+			enc := b64.StdEncoding.EncodeToString(val)
+			jsonEnc, err := json.Marshal(enc)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				plugin.Spec.Metadata[i].Value = pluginsapi.DynamicValue{
+					JSON: v1.JSON{
+						Raw: jsonEnc,
+					},
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListPlugins returns a list of Dapr plugins.
+func (a *apiServer) ListPlugins(ctx context.Context, in *operatorv1pb.ListPluginsRequest) (*operatorv1pb.ListPluginsResponse, error) {
+	var plugins pluginsapi.PluginList
+	if err := a.Client.List(ctx, &plugins, &client.ListOptions{
+		Namespace: in.Namespace,
+	}); err != nil {
+		return nil, errors.Wrap(err, "error getting plugins")
+	}
+	resp := &operatorv1pb.ListPluginsResponse{
+		Plugins: [][]byte{},
+	}
+	for i := range plugins.Items {
+		p := plugins.Items[i] // Make a copy since we will refer to this as a reference in this loop.
+		err := processPluginSecrets(&p, in.Namespace, a.Client)
+		if err != nil {
+			log.Warnf("error processing plugin %s secrets: %s", p.Name, err)
+			return &operatorv1pb.ListPluginsResponse{}, err
+		}
+
+		b, err := json.Marshal(&p)
+		if err != nil {
+			log.Warnf("error marshalling plugin %s : %s", p.Name, err)
+			continue
+		}
+		resp.Plugins = append(resp.Plugins, b)
+	}
+	return resp, nil
+}
+
 // ListSubscriptions returns a list of Dapr pub/sub subscriptions.
 func (a *apiServer) ListSubscriptions(ctx context.Context, in *emptypb.Empty) (*operatorv1pb.ListSubscriptionsResponse, error) {
 	resp := &operatorv1pb.ListSubscriptionsResponse{
@@ -268,9 +348,66 @@ func (a *apiServer) ComponentUpdate(in *operatorv1pb.ComponentUpdateRequest, srv
 	}
 }
 
+// PluginUpdate updates Dapr sidecars whenever a plugin in the cluster is modified.
+func (a *apiServer) PluginUpdate(in *operatorv1pb.PluginUpdateRequest, srv operatorv1pb.Operator_PluginUpdateServer) error {
+	log.Info("sidecar connected for plugin updates")
+	key := uuid.New().String()
+	a.connLock.Lock()
+	a.allPluginConnUpdateChan[key] = make(chan *pluginsapi.Plugin, 1)
+	updateChan := a.allPluginConnUpdateChan[key]
+	a.connLock.Unlock()
+	defer func() {
+		a.connLock.Lock()
+		delete(a.allPluginConnUpdateChan, key)
+		a.connLock.Unlock()
+	}()
+	chWrapper := initPluginChanGracefully(updateChan)
+	updatePluginFunc := func(p *pluginsapi.Plugin) {
+		if p.Namespace != in.Namespace {
+			return
+		}
+
+		// the plugin is shared with the informer cache
+		p = p.DeepCopy()
+		err := processPluginSecrets(p, in.Namespace, a.Client)
+		if err != nil {
+			log.Warnf("error processing plugin %s secrets: %s", p.Name, err)
+			return
+		}
+
+		b, err := json.Marshal(&p)
+		if err != nil {
+			log.Warnf("error serializing plugin %s (%s): %s", p.GetName(), p.Spec.Type, err)
+			return
+		}
+		err = srv.Send(&operatorv1pb.PluginUpdateEvent{
+			Plugin: b,
+		})
+		if err != nil {
+			log.Warnf("error updating sidecar with plugin %s (%s): %s", p.GetName(), p.Spec.Type, err)
+			if status.Code(err) == codes.Unavailable {
+				chWrapper.Close()
+			}
+			return
+		}
+		log.Infof("updated sidecar with plugin %s (%s)", p.GetName(), p.Spec.Type)
+	}
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case p, ok := <-updateChan:
+			if !ok {
+				return nil
+			}
+			go updatePluginFunc(p)
+		}
+	}
+}
+
 // chanGracefully control channel to close gracefully in multi-goroutines.
 type chanGracefully struct {
-	ch       chan *componentsapi.Component
+	closeFn  func()
 	isClosed bool
 	sync.Mutex
 }
@@ -278,7 +415,15 @@ type chanGracefully struct {
 func initChanGracefully(ch chan *componentsapi.Component) (
 	c *chanGracefully) {
 	return &chanGracefully{
-		ch:       ch,
+		closeFn:  func() { close(ch) },
+		isClosed: false,
+	}
+}
+
+func initPluginChanGracefully(ch chan *pluginsapi.Plugin) (
+	c *chanGracefully) {
+	return &chanGracefully{
+		closeFn:  func() { close(ch) },
 		isClosed: false,
 	}
 }
@@ -288,7 +433,7 @@ func (c *chanGracefully) Close() {
 	c.Lock()
 	if !c.isClosed {
 		c.isClosed = true
-		close(c.ch)
+		c.closeFn()
 	}
 	c.Unlock()
 }
@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	pluginsapi "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	"github.com/dapr/dapr/pkg/client/clientset/versioned/scheme"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
@@ -46,6 +47,20 @@ func (m *mockComponentUpdateServer) Context() context.Context {
 	return context.TODO()
 }
 
+type mockPluginUpdateServer struct {
+	grpc.ServerStream
+	Calls int
+}
+
+func (m *mockPluginUpdateServer) Send(*operatorv1pb.PluginUpdateEvent) error {
+	m.Calls++
+	return nil
+}
+
+func (m *mockPluginUpdateServer) Context() context.Context {
+	return context.TODO()
+}
+
 func TestProcessComponentSecrets(t *testing.T) {
 	t.Run("secret ref exists, not kubernetes secret store, no error", func(t *testing.T) {
 		c := componentsapi.Component{
@@ -164,6 +179,72 @@ func TestProcessComponentSecrets(t *testing.T) {
 	})
 }
 
+func TestProcessPluginSecrets(t *testing.T) {
+	t.Run("secret ref exists, not kubernetes secret store, no error", func(t *testing.T) {
+		p := pluginsapi.Plugin{
+			Spec: pluginsapi.PluginSpec{
+				Metadata: []pluginsapi.MetadataItem{
+					{
+						Name: "test1",
+						SecretKeyRef: pluginsapi.SecretKeyRef{
+							Name: "secret1",
+							Key:  "key1",
+						},
+					},
+				},
+			},
+			Auth: pluginsapi.Auth{
+				SecretStore: "secretstore",
+			},
+		}
+
+		err := processPluginSecrets(&p, "default", nil)
+		assert.NoError(t, err)
+		assert.Empty(t, p.Spec.Metadata[0].Value.Raw)
+	})
+
+	t.Run("secret ref exists, default kubernetes secret store, secret extracted", func(t *testing.T) {
+		p := pluginsapi.Plugin{
+			Spec: pluginsapi.PluginSpec{
+				Metadata: []pluginsapi.MetadataItem{
+					{
+						Name: "test1",
+						SecretKeyRef: pluginsapi.SecretKeyRef{
+							Name: "secret1",
+							Key:  "key1",
+						},
+					},
+				},
+			},
+		}
+
+		s := runtime.NewScheme()
+		err := corev1.AddToScheme(s)
+		assert.NoError(t, err)
+
+		client := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret1",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"key1": []byte("value1"),
+				},
+			}).
+			Build()
+
+		err = processPluginSecrets(&p, "default", client)
+		assert.NoError(t, err)
+
+		enc := base64.StdEncoding.EncodeToString([]byte("value1"))
+		jsonEnc, _ := json.Marshal(enc)
+
+		assert.Equal(t, jsonEnc, p.Spec.Metadata[0].Value.Raw)
+	})
+}
+
 func TestChanGracefullyClose(t *testing.T) {
 	t.Run("close updateChan", func(t *testing.T) {
 		ch := make(chan *componentsapi.Component)
@@ -258,3 +339,87 @@ func TestComponentUpdate(t *testing.T) {
 		assert.Equal(t, 1, mockSidecar.Calls)
 	})
 }
+
+func TestPluginUpdate(t *testing.T) {
+	runPluginUpdate := func(t *testing.T, p *pluginsapi.Plugin, namespace string) int {
+		s := runtime.NewScheme()
+		err := scheme.AddToScheme(s)
+		assert.NoError(t, err)
+
+		client := fake.NewClientBuilder().
+			WithScheme(s).Build()
+
+		mockSidecar := &mockPluginUpdateServer{}
+		api := NewAPIServer(client).(*apiServer)
+
+		go func() {
+			// Send a plugin update, give sidecar time to register
+			time.Sleep(time.Millisecond * 500)
+
+			api.connLock.Lock()
+			defer api.connLock.Unlock()
+			for _, connUpdateChan := range api.allPluginConnUpdateChan {
+				connUpdateChan <- p
+
+				// Give sidecar time to register update
+				time.Sleep(time.Millisecond * 500)
+				close(connUpdateChan)
+			}
+		}()
+
+		// Start sidecar update loop
+		api.PluginUpdate(&operatorv1pb.PluginUpdateRequest{
+			Namespace: namespace,
+		}, mockSidecar)
+
+		return mockSidecar.Calls
+	}
+
+	p := &pluginsapi.Plugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+		},
+		Spec: pluginsapi.PluginSpec{},
+	}
+
+	t.Run("skip sidecar update if namespace doesn't match", func(t *testing.T) {
+		assert.Zero(t, runPluginUpdate(t, p, "ns2"))
+	})
+
+	t.Run("sidecar is updated when plugin namespace is a match", func(t *testing.T) {
+		assert.Equal(t, 1, runPluginUpdate(t, p, "ns1"))
+	})
+}
+
+func TestListPlugins(t *testing.T) {
+	s := runtime.NewScheme()
+	err := pluginsapi.AddToScheme(s)
+	assert.NoError(t, err)
+
+	p := &pluginsapi.Plugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "plugin",
+			Namespace: "ns1",
+		},
+		Spec: pluginsapi.PluginSpec{
+			Type: "GRPC",
+		},
+	}
+	client := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(p).
+		Build()
+
+	api := NewAPIServer(client).(*apiServer)
+	resp, err := api.ListPlugins(context.TODO(), &operatorv1pb.ListPluginsRequest{
+		Namespace: "ns1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Plugins, 1)
+
+	var plugin pluginsapi.Plugin
+	err = json.Unmarshal(resp.Plugins[0], &plugin)
+	assert.NoError(t, err)
+	assert.Equal(t, "plugin", plugin.Name)
+	assert.Equal(t, "GRPC", plugin.Spec.Type)
+}
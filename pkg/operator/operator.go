@@ -25,6 +25,7 @@ import (
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	pluginsapi "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	subscriptionsapi_v1alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	subscriptionsapi_v2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/credentials"
@@ -66,6 +67,7 @@ func init() {
 
 	_ = componentsapi.AddToScheme(scheme)
 	_ = configurationapi.AddToScheme(scheme)
+	_ = pluginsapi.AddToScheme(scheme)
 	_ = subscriptionsapi_v1alpha1.AddToScheme(scheme)
 	_ = subscriptionsapi_v2alpha1.AddToScheme(scheme)
 }
@@ -108,6 +110,16 @@ func NewOperator(config, certChainPath string, enableLeaderElection bool) Operat
 			},
 		})
 	}
+	if pluginInformer, err := mgr.GetCache().GetInformer(context.TODO(), &pluginsapi.Plugin{}); err != nil {
+		log.Fatalf("unable to get setup plugins informer, err: %s", err)
+	} else {
+		pluginInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: o.syncPlugin,
+			UpdateFunc: func(_, newObj interface{}) {
+				o.syncPlugin(newObj)
+			},
+		})
+	}
 	return o
 }
 
@@ -128,6 +140,14 @@ func (o *operator) syncComponent(obj interface{}) {
 	}
 }
 
+func (o *operator) syncPlugin(obj interface{}) {
+	p, ok := obj.(*pluginsapi.Plugin)
+	if ok {
+		log.Debugf("observed plugin to be synced, %s/%s", p.Namespace, p.Name)
+		o.apiServer.OnPluginUpdated(p)
+	}
+}
+
 func (o *operator) Run(ctx context.Context) {
 	defer runtimeutil.HandleCrash()
 	ctx, cancel := context.WithCancel(ctx)
@@ -1,12 +1,29 @@
 package plugin
 
-import config "github.com/dapr/dapr/pkg/config/modes"
+import (
+	"time"
+
+	config "github.com/dapr/dapr/pkg/config/modes"
+	"github.com/dapr/dapr/pkg/runtime/security"
+)
 
 // Config defines the configuration for a plugin
 type Config struct {
-	Name       string
-	Version    string
-	Type       string
+	Name    string
+	Version string
+	Type    string
+	// Address is the address of a remote-address plugin
+	Address string
+	// Timeout bounds each call to the plugin. Zero disables the timeout
+	Timeout time.Duration
+	// ComponentTypes are the types of the components served by the plugin, e.g. state and pubsub
+	ComponentTypes []string
+	// TLS configures the transport security of the connection to plugins reached over the network
+	TLS TLSConfig
+	// Authenticator provides the workload certificate of the runtime for mtls connections. It is nil when mTLS is disabled
+	Authenticator security.Authenticator
+	// Namespace is the namespace of the runtime
+	Namespace  string
 	Standalone config.StandaloneConfig
 	Kubernetes config.KubernetesConfig
 }
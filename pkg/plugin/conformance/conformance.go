@@ -0,0 +1,158 @@
+// Package conformance checks that a plugin honours the contract of the components it serves.
+// The plugin is launched through the same go-plugin client as the runtime in standalone mode, so plugin authors can run the checks in their own CI:
+//
+//	report, err := conformance.RunStateTests(conformance.Config{Package: "./dist/my-plugin.tar.gz"})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	if report.Failed() {
+//		t.Fatal(report)
+//	}
+package conformance
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dapr/components-contrib/configuration"
+	config "github.com/dapr/dapr/pkg/config/modes"
+	"github.com/dapr/dapr/pkg/plugin"
+	"github.com/dapr/dapr/pkg/plugin/standalone"
+	"github.com/dapr/dapr/pkg/plugin/standalone/packages"
+	"github.com/dapr/kit/logger"
+)
+
+// DefaultTimeout bounds each call to the plugin under test.
+const DefaultTimeout = 5 * time.Second
+
+// Config locates the plugin under test.
+type Config struct {
+	// Package is a plugin package directory or tarball, which is installed in a temporary plugins directory before the plugin is launched
+	Package string
+	// PluginsPath, Name and Version locate an installed plugin when Package is empty
+	PluginsPath string
+	Name        string
+	Version     string
+	// Metadata are the properties the component is initialized with
+	Metadata map[string]string
+	// Timeout bounds each call to the plugin, DefaultTimeout when zero
+	Timeout time.Duration
+	// ClientProtocolFactory connects to the launched plugin, standalone.DefaultClientProtocolFactory when nil
+	ClientProtocolFactory standalone.ClientProtocolFactory
+}
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusPass Status = "PASS"
+	StatusFail Status = "FAIL"
+	StatusSkip Status = "SKIP"
+)
+
+// Result is the outcome of a check of the contract.
+type Result struct {
+	Name    string
+	Status  Status
+	Message string
+}
+
+// Report lists the results of the checks of a plugin.
+type Report struct {
+	Plugin        string
+	ComponentType string
+	Results       []Result
+}
+
+// Failed returns true if any check failed.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s conformance of plugin %s\n", r.ComponentType, r.Plugin)
+	for _, result := range r.Results {
+		if result.Message == "" {
+			fmt.Fprintf(&b, "%s %s\n", result.Status, result.Name)
+		} else {
+			fmt.Fprintf(&b, "%s %s: %s\n", result.Status, result.Name, result.Message)
+		}
+	}
+	return b.String()
+}
+
+// check runs the check and records its result. It returns true if the check passed.
+func (r *Report) check(name string, fn func() error) bool {
+	if err := fn(); err != nil {
+		r.Results = append(r.Results, Result{Name: name, Status: StatusFail, Message: err.Error()})
+		return false
+	}
+	r.Results = append(r.Results, Result{Name: name, Status: StatusPass})
+	return true
+}
+
+func (r *Report) skip(name, reason string) {
+	r.Results = append(r.Results, Result{Name: name, Status: StatusSkip, Message: reason})
+}
+
+// launch installs the plugin package when configured and starts the plugin like the runtime does. The returned function stops the plugin and removes the temporary plugins directory.
+func launch(cfg Config, componentType string) (plugin.Plugin, string, func(), error) {
+	cleanup := func() {}
+	if cfg.Package != "" {
+		pluginsPath, err := os.MkdirTemp("", "dapr-conformance-")
+		if err != nil {
+			return nil, "", nil, err
+		}
+		cleanup = func() { os.RemoveAll(pluginsPath) }
+		manifest, err := packages.NewManager(pluginsPath).Install(cfg.Package)
+		if err != nil {
+			cleanup()
+			return nil, "", nil, err
+		}
+		cfg.PluginsPath, cfg.Name, cfg.Version = pluginsPath, manifest.Name, manifest.Version
+	}
+	pluginsPath, err := filepath.Abs(cfg.PluginsPath)
+	if err != nil {
+		cleanup()
+		return nil, "", nil, err
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.ClientProtocolFactory == nil {
+		cfg.ClientProtocolFactory = standalone.DefaultClientProtocolFactory
+	}
+
+	name := cfg.Name + "/" + cfg.Version
+	// the plugin is not supervised, so a crashed plugin fails the checks instead of being restarted
+	p := standalone.NewPlugin(logger.NewLogger("dapr.plugin.conformance"), plugin.Config{
+		Name:           cfg.Name,
+		Version:        cfg.Version,
+		Type:           plugin.TypeExec,
+		Timeout:        cfg.Timeout,
+		ComponentTypes: []string{componentType},
+		Standalone: config.StandaloneConfig{
+			PluginsPath: pluginsPath,
+		},
+	}, os.DirFS(standalone.FilesystemRoot(pluginsPath)), cfg.ClientProtocolFactory, standalone.WithSupervisor(standalone.SupervisorConfig{}))
+	if err = p.Init(configuration.Metadata{}); err != nil {
+		cleanup()
+		return nil, "", nil, fmt.Errorf("plugin %s could not be launched: %w", name, err)
+	}
+	return p, name, func() {
+		if closer, ok := p.(io.Closer); ok {
+			closer.Close()
+		}
+		cleanup()
+	}, nil
+}
@@ -0,0 +1,362 @@
+package conformance
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/plugin/standalone"
+)
+
+// knownStateFeatures are the features a state store can advertise.
+var knownStateFeatures = []state.Feature{state.FeatureETag, state.FeatureTransactional}
+
+// RunStateTests launches the plugin and checks that its state store honours the state.Store contract:
+// get, set and delete, the bulk calls, the consistency and concurrency options, the advertised features, and the etags and transactions when they are advertised.
+// An error is returned when the plugin cannot be launched. The failed checks are listed in the report.
+func RunStateTests(cfg Config) (*Report, error) {
+	p, name, cleanup, err := launch(cfg, standalone.ComponentTypeState)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	store, err := p.Store()
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s does not serve a state store: %w", name, err)
+	}
+	report := &Report{
+		Plugin:        name,
+		ComponentType: standalone.ComponentTypeState,
+	}
+	s := &stateChecks{
+		store:    store,
+		metadata: cfg.Metadata,
+		prefix:   fmt.Sprintf("conformance-%d-", time.Now().UnixNano()),
+	}
+	s.run(report)
+	return report, nil
+}
+
+type stateChecks struct {
+	store    state.Store
+	metadata map[string]string
+	// prefix makes the keys of a run unique, so runs against a shared store do not interfere
+	prefix string
+}
+
+func (s *stateChecks) key(name string) string {
+	return s.prefix + name
+}
+
+func (s *stateChecks) run(report *Report) {
+	if !report.check("init", func() error {
+		return s.store.Init(state.Metadata{Properties: s.metadata})
+	}) {
+		report.skip("contract", "the store failed to initialize")
+		return
+	}
+
+	features := s.store.Features()
+	report.check("features", func() error {
+		seen := map[state.Feature]bool{}
+		for _, feature := range features {
+			if !feature.IsPresent(knownStateFeatures) {
+				return fmt.Errorf("unknown feature %s", feature)
+			}
+			if seen[feature] {
+				return fmt.Errorf("feature %s is advertised twice", feature)
+			}
+			seen[feature] = true
+		}
+		return nil
+	})
+
+	report.check("get missing key", func() error {
+		return s.expectMissing(s.key("missing"))
+	})
+	report.check("set and get", func() error {
+		if err := s.store.Set(&state.SetRequest{Key: s.key("a"), Value: []byte("value-1")}); err != nil {
+			return err
+		}
+		return s.expectValue(s.key("a"), "value-1")
+	})
+	report.check("set overwrites", func() error {
+		if err := s.store.Set(&state.SetRequest{Key: s.key("a"), Value: []byte("value-2")}); err != nil {
+			return err
+		}
+		return s.expectValue(s.key("a"), "value-2")
+	})
+	report.check("delete", func() error {
+		if err := s.store.Delete(&state.DeleteRequest{Key: s.key("a")}); err != nil {
+			return err
+		}
+		return s.expectMissing(s.key("a"))
+	})
+	report.check("delete missing key", func() error {
+		return s.store.Delete(&state.DeleteRequest{Key: s.key("missing")})
+	})
+	report.check("consistency options", func() error {
+		for _, consistency := range []string{state.Strong, state.Eventual} {
+			if err := s.store.Set(&state.SetRequest{
+				Key:     s.key("consistency"),
+				Value:   []byte(consistency),
+				Options: state.SetStateOption{Consistency: consistency},
+			}); err != nil {
+				return fmt.Errorf("set with %s consistency: %w", consistency, err)
+			}
+			response, err := s.store.Get(&state.GetRequest{
+				Key:     s.key("consistency"),
+				Options: state.GetStateOption{Consistency: consistency},
+			})
+			if err != nil {
+				return fmt.Errorf("get with %s consistency: %w", consistency, err)
+			}
+			if !bytes.Equal(response.Data, []byte(consistency)) {
+				return fmt.Errorf("get with %s consistency returned %q", consistency, response.Data)
+			}
+		}
+		return s.store.Delete(&state.DeleteRequest{Key: s.key("consistency"), Options: state.DeleteStateOption{Consistency: state.Strong}})
+	})
+	report.check("last-write concurrency", func() error {
+		if err := s.store.Set(&state.SetRequest{
+			Key:     s.key("last-write"),
+			Value:   []byte("value"),
+			Options: state.SetStateOption{Concurrency: state.LastWrite},
+		}); err != nil {
+			return err
+		}
+		return s.store.Delete(&state.DeleteRequest{Key: s.key("last-write"), Options: state.DeleteStateOption{Concurrency: state.LastWrite}})
+	})
+	s.runBulk(report)
+
+	if state.FeatureETag.IsPresent(features) {
+		s.runETag(report)
+	} else {
+		report.skip("etags", "ETAG is not advertised")
+	}
+	if state.FeatureTransactional.IsPresent(features) {
+		s.runTransactions(report)
+	} else {
+		report.skip("transactions", "TRANSACTIONAL is not advertised")
+	}
+}
+
+func (s *stateChecks) runBulk(report *Report) {
+	keys := []string{s.key("bulk-1"), s.key("bulk-2"), s.key("bulk-3")}
+	if !report.check("bulk set", func() error {
+		requests := make([]state.SetRequest, len(keys))
+		for i, key := range keys {
+			requests[i] = state.SetRequest{Key: key, Value: []byte(key)}
+		}
+		if err := s.store.BulkSet(requests); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := s.expectValue(key, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}) {
+		report.skip("bulk get", "bulk set failed")
+		report.skip("bulk delete", "bulk set failed")
+		return
+	}
+
+	requests := make([]state.GetRequest, len(keys)+1)
+	for i, key := range keys {
+		requests[i] = state.GetRequest{Key: key}
+	}
+	requests[len(keys)] = state.GetRequest{Key: s.key("missing")}
+	supported, responses, err := s.store.BulkGet(requests)
+	switch {
+	case err != nil:
+		report.check("bulk get", func() error { return err })
+	case !supported:
+		report.skip("bulk get", "the store lets the runtime fall back to single gets")
+	default:
+		report.check("bulk get", func() error {
+			found := map[string]state.BulkGetResponse{}
+			for _, response := range responses {
+				found[response.Key] = response
+			}
+			for _, key := range keys {
+				response, ok := found[key]
+				if !ok {
+					return fmt.Errorf("no response for key %s", key)
+				}
+				if response.Error != "" {
+					return fmt.Errorf("key %s: %s", key, response.Error)
+				}
+				if string(response.Data) != key {
+					return fmt.Errorf("key %s: got %q", key, response.Data)
+				}
+			}
+			if response, ok := found[s.key("missing")]; ok && len(response.Data) != 0 {
+				return fmt.Errorf("missing key %s returned %q", s.key("missing"), response.Data)
+			}
+			return nil
+		})
+	}
+
+	report.check("bulk delete", func() error {
+		requests := make([]state.DeleteRequest, len(keys))
+		for i, key := range keys {
+			requests[i] = state.DeleteRequest{Key: key}
+		}
+		if err := s.store.BulkDelete(requests); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := s.expectMissing(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *stateChecks) runETag(report *Report) {
+	key := s.key("etag")
+	var etag string
+	if !report.check("etag returned", func() error {
+		if err := s.store.Set(&state.SetRequest{Key: key, Value: []byte("value-1")}); err != nil {
+			return err
+		}
+		var err error
+		etag, err = s.etag(key)
+		return err
+	}) {
+		report.skip("etags", "no etag is returned")
+		return
+	}
+
+	stale := etag
+	report.check("etag changes on write", func() error {
+		if err := s.store.Set(&state.SetRequest{Key: key, Value: []byte("value-2")}); err != nil {
+			return err
+		}
+		current, err := s.etag(key)
+		if err != nil {
+			return err
+		}
+		if current == stale {
+			return fmt.Errorf("etag %s did not change", current)
+		}
+		etag = current
+		return nil
+	})
+	report.check("set with stale etag", func() error {
+		return expectETagMismatch(s.store.Set(&state.SetRequest{Key: key, Value: []byte("stale"), ETag: &stale}))
+	})
+	report.check("first-write concurrency", func() error {
+		return expectETagMismatch(s.store.Set(&state.SetRequest{
+			Key:     key,
+			Value:   []byte("stale"),
+			ETag:    &stale,
+			Options: state.SetStateOption{Concurrency: state.FirstWrite},
+		}))
+	})
+	report.check("set with current etag", func() error {
+		current := etag
+		if err := s.store.Set(&state.SetRequest{Key: key, Value: []byte("value-3"), ETag: &current}); err != nil {
+			return err
+		}
+		return s.expectValue(key, "value-3")
+	})
+	report.check("delete with stale etag", func() error {
+		return expectETagMismatch(s.store.Delete(&state.DeleteRequest{Key: key, ETag: &stale}))
+	})
+	report.check("delete with current etag", func() error {
+		current, err := s.etag(key)
+		if err != nil {
+			return err
+		}
+		if err = s.store.Delete(&state.DeleteRequest{Key: key, ETag: &current}); err != nil {
+			return err
+		}
+		return s.expectMissing(key)
+	})
+}
+
+func (s *stateChecks) runTransactions(report *Report) {
+	transactional, ok := s.store.(state.TransactionalStore)
+	if !ok {
+		report.check("transactions", func() error {
+			return errors.New("TRANSACTIONAL is advertised but the store is not transactional")
+		})
+		return
+	}
+	report.check("transactions", func() error {
+		if err := s.store.Set(&state.SetRequest{Key: s.key("tx-deleted"), Value: []byte("value")}); err != nil {
+			return err
+		}
+		if err := transactional.Multi(&state.TransactionalStateRequest{
+			Operations: []state.TransactionalStateOperation{
+				{Operation: state.Upsert, Request: state.SetRequest{Key: s.key("tx-1"), Value: []byte("value-1")}},
+				{Operation: state.Upsert, Request: state.SetRequest{Key: s.key("tx-2"), Value: []byte("value-2")}},
+				{Operation: state.Delete, Request: state.DeleteRequest{Key: s.key("tx-deleted")}},
+			},
+		}); err != nil {
+			return err
+		}
+		if err := s.expectValue(s.key("tx-1"), "value-1"); err != nil {
+			return err
+		}
+		if err := s.expectValue(s.key("tx-2"), "value-2"); err != nil {
+			return err
+		}
+		if err := s.expectMissing(s.key("tx-deleted")); err != nil {
+			return err
+		}
+		return s.store.BulkDelete([]state.DeleteRequest{{Key: s.key("tx-1")}, {Key: s.key("tx-2")}})
+	})
+}
+
+func (s *stateChecks) expectValue(key, value string) error {
+	response, err := s.store.Get(&state.GetRequest{Key: key})
+	if err != nil {
+		return err
+	}
+	if response == nil || string(response.Data) != value {
+		return fmt.Errorf("get %s: expected %q, got %v", key, value, response)
+	}
+	return nil
+}
+
+func (s *stateChecks) expectMissing(key string) error {
+	response, err := s.store.Get(&state.GetRequest{Key: key})
+	if err != nil {
+		return err
+	}
+	if response != nil && len(response.Data) != 0 {
+		return fmt.Errorf("get %s: expected no data, got %q", key, response.Data)
+	}
+	return nil
+}
+
+func (s *stateChecks) etag(key string) (string, error) {
+	response, err := s.store.Get(&state.GetRequest{Key: key})
+	if err != nil {
+		return "", err
+	}
+	if response == nil || response.ETag == nil || *response.ETag == "" {
+		return "", fmt.Errorf("get %s: ETAG is advertised but no etag is returned", key)
+	}
+	return *response.ETag, nil
+}
+
+// expectETagMismatch returns an error unless err is an etag mismatch, which the runtime turns into a conflict.
+func expectETagMismatch(err error) error {
+	if err == nil {
+		return errors.New("expected an etag mismatch error, got none")
+	}
+	var etagErr *state.ETagError
+	if !errors.As(err, &etagErr) || etagErr.Kind() != state.ETagMismatch {
+		return fmt.Errorf("expected an etag mismatch error, got %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package conformance_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/plugin"
+	"github.com/dapr/dapr/pkg/plugin/conformance"
+	"github.com/dapr/dapr/pkg/plugin/standalone"
+	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// clientProtocol serves a store over an in-process connection, in place of a plugin process
+type clientProtocol struct {
+	*grpc.ClientConn
+	server *grpc.Server
+}
+
+func (p *clientProtocol) Dispense(name string) (interface{}, error) {
+	return nil, fmt.Errorf("unrecognized service %s", name)
+}
+
+func (p *clientProtocol) Ping() error {
+	return nil
+}
+
+func (p *clientProtocol) Close() error {
+	p.server.Stop()
+	return p.ClientConn.Close()
+}
+
+func clientProtocolFactory(store state.Store) standalone.ClientProtocolFactory {
+	return func(client *goplugin.Client) (goplugin.ClientProtocol, error) {
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		stateproto.RegisterStoreServer(server, &state_sdk.GRPCServer{Impl: store})
+		reflection.Register(server)
+		go server.Serve(listener)
+		conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return &clientProtocol{ClientConn: conn, server: server}, nil
+	}
+}
+
+// newPackage creates a plugin package whose plugin file is never executed by the tests
+func newPackage(t *testing.T) string {
+	dir := t.TempDir()
+	content := "#!/bin/sh\n"
+	checksum, err := standalone.Checksum(strings.NewReader(content))
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "dapr-test-v1"), []byte(content), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte("name: test\nversion: v1\nruntime: exec\nchecksum: "+checksum+"\ncomponentTypes: [state]\n"), 0o600))
+	return dir
+}
+
+// etagStore is a transactional memory store with a version per key as etag
+type etagStore struct {
+	*plugin.MemoryStore
+	lock     sync.Mutex
+	versions map[string]int
+	// fixedETag breaks the contract by returning the same etag after every write
+	fixedETag bool
+}
+
+func newETagStore() *etagStore {
+	return &etagStore{
+		MemoryStore: plugin.NewTransactionalMemoryStore(),
+		versions:    map[string]int{},
+	}
+}
+
+func (s *etagStore) Features() []state.Feature {
+	return []state.Feature{state.FeatureETag, state.FeatureTransactional}
+}
+
+func (s *etagStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	response, err := s.MemoryStore.Get(req)
+	if err != nil || response.Data == nil {
+		return response, err
+	}
+	etag := strconv.Itoa(s.versions[req.Key])
+	if s.fixedETag {
+		etag = "1"
+	}
+	response.ETag = &etag
+	return response, nil
+}
+
+func (s *etagStore) checkETag(key string, etag *string) error {
+	if etag != nil && *etag != strconv.Itoa(s.versions[key]) && !s.fixedETag {
+		return state.NewETagError(state.ETagMismatch, nil)
+	}
+	return nil
+}
+
+func (s *etagStore) Set(req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.checkETag(req.Key, req.ETag); err != nil {
+		return err
+	}
+	s.versions[req.Key]++
+	return s.MemoryStore.Set(req)
+}
+
+func (s *etagStore) Delete(req *state.DeleteRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.checkETag(req.Key, req.ETag); err != nil {
+		return err
+	}
+	delete(s.versions, req.Key)
+	return s.MemoryStore.Delete(req)
+}
+
+func statuses(report *conformance.Report) map[string]conformance.Status {
+	results := map[string]conformance.Status{}
+	for _, result := range report.Results {
+		results[result.Name] = result.Status
+	}
+	return results
+}
+
+func TestRunStateTests(t *testing.T) {
+	t.Run("store honouring the contract passes", func(t *testing.T) {
+		report, err := conformance.RunStateTests(conformance.Config{
+			Package:               newPackage(t),
+			ClientProtocolFactory: clientProtocolFactory(newETagStore()),
+		})
+		require.Nil(t, err)
+		require.False(t, report.Failed(), report.String())
+		require.Equal(t, "test/v1", report.Plugin)
+		results := statuses(report)
+		require.Equal(t, conformance.StatusPass, results["set with stale etag"])
+		require.Equal(t, conformance.StatusPass, results["transactions"])
+		require.Equal(t, conformance.StatusSkip, results["bulk get"])
+	})
+
+	t.Run("features that are not advertised are skipped", func(t *testing.T) {
+		report, err := conformance.RunStateTests(conformance.Config{
+			Package:               newPackage(t),
+			ClientProtocolFactory: clientProtocolFactory(plugin.NewMemoryStore()),
+		})
+		require.Nil(t, err)
+		require.False(t, report.Failed(), report.String())
+		results := statuses(report)
+		require.Equal(t, conformance.StatusSkip, results["etags"])
+		require.Equal(t, conformance.StatusSkip, results["transactions"])
+	})
+
+	t.Run("broken etags are reported", func(t *testing.T) {
+		store := newETagStore()
+		store.fixedETag = true
+		report, err := conformance.RunStateTests(conformance.Config{
+			Package:               newPackage(t),
+			ClientProtocolFactory: clientProtocolFactory(store),
+		})
+		require.Nil(t, err)
+		require.True(t, report.Failed())
+		results := statuses(report)
+		require.Equal(t, conformance.StatusFail, results["etag changes on write"])
+		require.Equal(t, conformance.StatusFail, results["set with stale etag"])
+		require.Contains(t, report.String(), "FAIL set with stale etag: expected an etag mismatch error, got none")
+	})
+
+	t.Run("plugin that cannot be launched is an error", func(t *testing.T) {
+		_, err := conformance.RunStateTests(conformance.Config{
+			PluginsPath: t.TempDir(),
+			Name:        "test",
+			Version:     "v1",
+		})
+		require.NotNil(t, err)
+	})
+}
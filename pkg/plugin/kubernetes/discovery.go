@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/dapr/dapr/pkg/env"
@@ -12,7 +14,8 @@ type Discovery interface {
 	Lookup(name, version string) (*Metadata, bool, error)
 }
 
-const DaprPluginPrefix = "DAPR_PLUGIN_"
+// DaprPluginPrefix names the env vars locating the plugins. It is distinct from the DAPR_PLUGIN_PORT variable of the plugin containers.
+const DaprPluginPrefix = "DAPR_PLUGIN_ENDPOINT_"
 
 type discovery struct {
 	environment env.Env
@@ -24,19 +27,70 @@ func NewDiscovery(environment env.Env) Discovery {
 	}
 }
 
+// Lookup returns the plugin metadata of the DAPR_PLUGIN_ENDPOINT_* variable naming the plugin version.
+// Only that variable is validated, so an invalid variable of another plugin doesn't prevent the plugin from loading.
 func (d *discovery) Lookup(name, version string) (*Metadata, bool, error) {
 	filtered := d.filter(d.environment.List(), DaprPluginPrefix)
-	all, err := d.transformAll(filtered)
+	for k, v := range filtered {
+		value := d.toYAML(v)
+		// a lenient parse identifies the plugin of the variable
+		var identity Metadata
+		if err := yaml.Unmarshal([]byte(value), &identity); err != nil || identity.Name != name || identity.Version != version {
+			continue
+		}
+		metadata, err := d.transform(k, value)
+		if err != nil {
+			return nil, false, err
+		}
+		return metadata, true, nil
+	}
+	return nil, false, nil
+}
+
+type addressDiscovery struct {
+	address string
+}
+
+// NewAddressDiscovery returns a discovery locating every plugin at the given host:port address, which is used by remote-address plugins.
+func NewAddressDiscovery(address string) Discovery {
+	return &addressDiscovery{
+		address: address,
+	}
+}
+
+func (d *addressDiscovery) Lookup(name, version string) (*Metadata, bool, error) {
+	host, port, err := net.SplitHostPort(d.address)
 	if err != nil {
-		return nil, false, err
+		return nil, false, fmt.Errorf("invalid address %q of plugin %s version %s: %w", d.address, name, version, err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid port in address %q of plugin %s version %s: %w", d.address, name, version, err)
+	}
+	return &Metadata{
+		Name:    name,
+		Version: version,
+		Address: host,
+		Port:    portNumber,
+	}, true, nil
+}
+
+type chainDiscovery struct {
+	discoveries []Discovery
+}
+
+// NewChainDiscovery returns a discovery locating a plugin with the first of the discoveries that knows it.
+func NewChainDiscovery(discoveries ...Discovery) Discovery {
+	return &chainDiscovery{
+		discoveries: discoveries,
 	}
-	return d.find(all, name, version)
 }
 
-func (d *discovery) find(all []*Metadata, name, version string) (*Metadata, bool, error) {
-	for _, item := range all {
-		if name == item.Name && version == item.Version {
-			return item, true, nil
+func (d *chainDiscovery) Lookup(name, version string) (*Metadata, bool, error) {
+	for _, discovery := range d.discoveries {
+		metadata, ok, err := discovery.Lookup(name, version)
+		if err != nil || ok {
+			return metadata, ok, err
 		}
 	}
 	return nil, false, nil
@@ -53,32 +107,16 @@ func (d *discovery) filter(list map[string]string, prefix string) map[string]str
 	return matched
 }
 
-func (d *discovery) transformAll(variables map[string]string) ([]*Metadata, error) {
-	var all []*Metadata
-	for k, v := range variables {
-		metadata, err := d.transform(k, v)
-		if err != nil {
-			return nil, err
-		}
-		all = append(all, metadata)
-	}
-	return all, nil
+// toYAML turns the pipe separated segments of a DAPR_PLUGIN_ENDPOINT_* variable into the lines of a yaml document.
+func (d *discovery) toYAML(value string) string {
+	return strings.Join(strings.Split(value, "|"), fmt.Sprintln())
 }
 
 func (d *discovery) transform(key, value string) (*Metadata, error) {
 	metadata := &Metadata{}
-	// first split the string by pipe and reset the value variable
-	splits := strings.Split(value, "|")
-	value = ""
-	// create a yaml string by joining the segments into lines
-	for i, s := range splits {
-		if i > 0 {
-			value += fmt.Sprintln()
-		}
-		value += fmt.Sprint(s)
+	// unmarshal the yaml string to the metadata variable, unknown keys such as ip instead of address are rejected
+	if err := yaml.UnmarshalStrict([]byte(value), &metadata); err != nil {
+		return nil, fmt.Errorf("invalid plugin metadata in %s: %w", key, err)
 	}
-	// unmarshal the yaml string to the metadata variable
-	err := yaml.Unmarshal([]byte(value), &metadata)
-
-	return metadata, err
+	return metadata, nil
 }
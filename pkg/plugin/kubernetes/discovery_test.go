@@ -1,19 +1,220 @@
 package kubernetes_test
 
 import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/env"
+	"github.com/dapr/dapr/pkg/plugin"
 	"github.com/dapr/dapr/pkg/plugin/kubernetes"
+	"github.com/dapr/kit/logger"
 	"github.com/stretchr/testify/require"
 )
 
 func TestLookup(t *testing.T) {
 	environment := env.NewMemory()
-	environment.Set("DAPR_PLUGIN_TEST", "name: test|version: v1|ip: 192.168.1.1|port: 9999")
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
 	discovery := kubernetes.NewDiscovery(environment)
 	metadata, ok, err := discovery.Lookup("test", "v1")
 	require.Nil(t, err)
 	require.True(t, ok)
-	require.NotNil(t, metadata)
+	require.Equal(t, &kubernetes.Metadata{Name: "test", Version: "v1", Address: "192.168.1.1", Port: 9999}, metadata)
+
+	t.Run("unknown keys are rejected", func(t *testing.T) {
+		environment := env.NewMemory()
+		environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|ip: 192.168.1.1|port: 9999")
+		_, _, err := kubernetes.NewDiscovery(environment).Lookup("test", "v1")
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "invalid plugin metadata in DAPR_PLUGIN_ENDPOINT_TEST")
+	})
+
+	t.Run("invalid variables of other plugins are ignored", func(t *testing.T) {
+		environment := env.NewMemory()
+		environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+		environment.Set("DAPR_PLUGIN_ENDPOINT_OTHER", "name: other|version: v1|ip: 192.168.1.2|port: 9999")
+		environment.Set("DAPR_PLUGIN_ENDPOINT_BROKEN", "name: [broken")
+		metadata, ok, err := kubernetes.NewDiscovery(environment).Lookup("test", "v1")
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.Equal(t, "192.168.1.1", metadata.Address)
+	})
+}
+
+func TestAddressLookup(t *testing.T) {
+	discovery := kubernetes.NewAddressDiscovery("10.0.0.1:50001")
+	metadata, ok, err := discovery.Lookup("test", "v1")
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, &kubernetes.Metadata{Name: "test", Version: "v1", Address: "10.0.0.1", Port: 50001}, metadata)
+
+	_, _, err = kubernetes.NewAddressDiscovery("10.0.0.1").Lookup("test", "v1")
+	require.NotNil(t, err)
+}
+
+func writeDiscoveryFile(t *testing.T, path, content string) {
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestFileLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugins.yaml")
+	writeDiscoveryFile(t, path, `plugins:
+- name: test
+  version: v1
+  address: plugin.example.com
+  port: 50001
+`)
+	discovery := kubernetes.NewFileDiscovery(path)
+
+	metadata, ok, err := discovery.Lookup("test", "v1")
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, &kubernetes.Metadata{Name: "test", Version: "v1", Address: "plugin.example.com", Port: 50001}, metadata)
+
+	_, ok, err = discovery.Lookup("test", "v2")
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	t.Run("invalid file", func(t *testing.T) {
+		writeDiscoveryFile(t, path, "plugins:\n- name: test\n  ip: 10.0.0.1\n")
+		_, _, err := discovery.Lookup("test", "v1")
+		require.NotNil(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, _, err := kubernetes.NewFileDiscovery(filepath.Join(t.TempDir(), "missing.yaml")).Lookup("test", "v1")
+		require.NotNil(t, err)
+	})
+}
+
+func TestChainLookup(t *testing.T) {
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	path := filepath.Join(t.TempDir(), "plugins.yaml")
+	writeDiscoveryFile(t, path, "plugins:\n- name: other\n  version: v1\n  address: 10.0.0.1\n  port: 50001\n")
+	discovery := kubernetes.NewChainDiscovery(kubernetes.NewFileDiscovery(path), kubernetes.NewDiscovery(environment))
+
+	metadata, ok, err := discovery.Lookup("other", "v1")
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, "10.0.0.1", metadata.Address)
+
+	metadata, ok, err = discovery.Lookup("test", "v1")
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, "192.168.1.1", metadata.Address)
+
+	_, ok, err = discovery.Lookup("missing", "v1")
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+type fakeSRVResolver struct {
+	records map[string][]*net.SRV
+	err     error
+}
+
+func (r *fakeSRVResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	if r.err != nil {
+		return "", nil, r.err
+	}
+	records, ok := r.records["_"+service+"._"+proto+"."+name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return "", records, nil
+}
+
+func TestServiceLookup(t *testing.T) {
+	resolver := &fakeSRVResolver{
+		records: map[string][]*net.SRV{
+			"_grpc._tcp.my-plugin-v1.default.svc": {
+				{Target: "my-plugin-v1.default.svc.cluster.local.", Port: 50001},
+			},
+		},
+	}
+	discovery := kubernetes.NewServiceDiscovery("default", resolver)
+
+	metadata, ok, err := discovery.Lookup("My-Plugin", "v1")
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, &kubernetes.Metadata{
+		Name:      "My-Plugin",
+		Version:   "v1",
+		Address:   "my-plugin-v1.default.svc.cluster.local",
+		Port:      50001,
+		Endpoints: []string{"my-plugin-v1.default.svc.cluster.local:50001"},
+	}, metadata)
+
+	_, ok, err = discovery.Lookup("my-plugin", "v2")
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	t.Run("all the endpoints of the lowest priority are returned in a stable order", func(t *testing.T) {
+		resolver := &fakeSRVResolver{
+			records: map[string][]*net.SRV{
+				"_grpc._tcp.my-plugin-v1.default.svc": {
+					{Target: "10-0-0-2.my-plugin-v1.default.svc.cluster.local.", Port: 50001, Weight: 50},
+					{Target: "10-0-0-3.my-plugin-v1.default.svc.cluster.local.", Port: 50001, Priority: 1},
+					{Target: "10-0-0-1.my-plugin-v1.default.svc.cluster.local.", Port: 50001, Weight: 50},
+				},
+			},
+		}
+		metadata, ok, err := kubernetes.NewServiceDiscovery("default", resolver).Lookup("my-plugin", "v1")
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.Equal(t, "10-0-0-1.my-plugin-v1.default.svc.cluster.local", metadata.Address)
+		require.Equal(t, []string{
+			"10-0-0-1.my-plugin-v1.default.svc.cluster.local:50001",
+			"10-0-0-2.my-plugin-v1.default.svc.cluster.local:50001",
+		}, metadata.Endpoints)
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		_, _, err := kubernetes.NewServiceDiscovery("default", &fakeSRVResolver{err: errors.New("timeout")}).Lookup("my-plugin", "v1")
+		require.NotNil(t, err)
+	})
+}
+
+func TestServiceName(t *testing.T) {
+	require.Equal(t, "my-plugin-v1", kubernetes.ServiceName("my-plugin", "v1"))
+	require.Equal(t, "my-plugin-1-2-0", kubernetes.ServiceName("My_Plugin", "1.2.0"))
+	require.Equal(t, "my-plugin", kubernetes.ServiceName("my-plugin", ""))
+}
+
+func TestConnectionFollowsMovedPlugin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugins.yaml")
+	writeEndpoint := func(port int) {
+		writeDiscoveryFile(t, path, "plugins:\n- name: test\n  version: v1\n  address: 127.0.0.1\n  port: "+strconv.Itoa(port)+"\n")
+	}
+
+	firstListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	first := newStoreServer(t, firstListener)
+	writeEndpoint(firstListener.Addr().(*net.TCPAddr).Port)
+
+	cfg := plugin.Config{Name: "test", Version: "v1", Timeout: time.Second}
+	discovery := kubernetes.NewFileDiscovery(path)
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), cfg, discovery, kubernetes.NewConnectionFactory(cfg, discovery))
+	require.Nil(t, p.Init(configuration.Metadata{}))
+	store, err := p.Store()
+	require.Nil(t, err)
+	require.Nil(t, store.Init(state.Metadata{}))
+	require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+
+	// the plugin moves to another endpoint
+	first.Stop()
+	secondPort := serveStore(t)
+	writeEndpoint(secondPort)
+
+	require.Eventually(t, func() bool {
+		return store.Set(&state.SetRequest{Key: "key", Value: "value"}) == nil
+	}, 10*time.Second, 100*time.Millisecond)
 }
@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// fileDiscoveryDocument is the content of a static plugin discovery file.
+type fileDiscoveryDocument struct {
+	Plugins []*Metadata `yaml:"plugins"`
+}
+
+type fileDiscovery struct {
+	path string
+}
+
+// NewFileDiscovery returns a discovery locating plugins from a static YAML file listing their name, version, address and port under plugins.
+// The file is read on every lookup, so an edited endpoint is picked up when the plugin is resolved again.
+func NewFileDiscovery(path string) Discovery {
+	return &fileDiscovery{
+		path: path,
+	}
+}
+
+func (d *fileDiscovery) Lookup(name, version string) (*Metadata, bool, error) {
+	b, err := os.ReadFile(d.path)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read plugin discovery file %s: %w", d.path, err)
+	}
+	var document fileDiscoveryDocument
+	if err := yaml.UnmarshalStrict(b, &document); err != nil {
+		return nil, false, fmt.Errorf("invalid plugin discovery file %s: %w", d.path, err)
+	}
+	for _, item := range document.Plugins {
+		if item != nil && name == item.Name && version == item.Version {
+			return item, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -1,8 +1,24 @@
 package kubernetes
 
+import (
+	"net"
+	"strconv"
+)
+
 type Metadata struct {
 	Name    string `yaml:"name"`
 	Version string `yaml:"version"`
 	Address string `yaml:"address"`
 	Port    int    `yaml:"port"`
+	// Endpoints are the host:port of every endpoint serving the plugin in a stable order, set by the discoveries finding several of them.
+	// Address and Port are the first endpoint.
+	Endpoints []string `yaml:"-"`
+}
+
+// addresses returns the host:port of the endpoints serving the plugin.
+func (m *Metadata) addresses() []string {
+	if len(m.Endpoints) > 0 {
+		return m.Endpoints
+	}
+	return []string{net.JoinHostPort(m.Address, strconv.Itoa(m.Port))}
 }
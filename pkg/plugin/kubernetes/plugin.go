@@ -3,12 +3,31 @@ package kubernetes
 import (
 	"fmt"
 	"net"
+	"strconv"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/plugin"
+	bindingsproto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	configurationproto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	middlewareproto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+	nameresolutionproto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	pluginproto "github.com/dapr/dapr/pkg/proto/plugin/v1"
+	pubsubproto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	secretstoresproto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
 	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	bindingssdk "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	configurationsdk "github.com/dapr/dapr/pkg/sdk/configuration/v1"
+	middlewaresdk "github.com/dapr/dapr/pkg/sdk/middleware/v1"
+	nameresolutionsdk "github.com/dapr/dapr/pkg/sdk/nameresolution/v1"
+	pluginsdk "github.com/dapr/dapr/pkg/sdk/plugin/v1"
+	pubsubsdk "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
+	secretstoressdk "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
 	statesdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 	"github.com/dapr/kit/logger"
 	"google.golang.org/grpc"
@@ -16,15 +35,33 @@ import (
 
 type ConnectionFactory func(*Metadata) (*grpc.ClientConn, error)
 
-func DefaultConnectionFactory(metadata *Metadata) (*grpc.ClientConn, error) {
-	ipAddress := net.ParseIP(metadata.Address)
-	if ipAddress == nil {
-		return nil, fmt.Errorf("%s is not a valid ip address", metadata.Address)
-	}
+// ServerNamer is implemented by the discoveries whose plugin endpoints move under a stable DNS name, which the plugin certificates are issued for.
+type ServerNamer interface {
+	ServerName(name, version string) string
+}
+
+// NewConnectionFactory returns a connection factory dialing plugins by DNS name or IP address with the transport security of the plugin configuration.
+// The connection resolves the plugin again through the discovery when the connection fails and every DefaultResolveInterval, so it follows a moved plugin endpoint.
+// TLS connections verify the name given by the discovery when it is a ServerNamer, and the first resolved address otherwise, so the endpoints the discovery moves to must share its certificate.
+func NewConnectionFactory(cfg plugin.Config, discovery Discovery) ConnectionFactory {
+	return func(metadata *Metadata) (*grpc.ClientConn, error) {
+		if metadata.Address == "" {
+			return nil, fmt.Errorf("plugin %s version %s has no address", metadata.Name, metadata.Version)
+		}
+		serverName := metadata.Address
+		if namer, ok := discovery.(ServerNamer); ok {
+			serverName = namer.ServerName(cfg.Name, cfg.Version)
+		}
+		creds, err := transportCredentials(cfg, serverName)
+		if err != nil {
+			return nil, err
+		}
 
-	addressAndPort := fmt.Sprintf("%s:%d", metadata.Address, metadata.Port)
+		addressAndPort := net.JoinHostPort(metadata.Address, strconv.Itoa(metadata.Port))
+		builder := newDiscoveryResolverBuilder(discovery, cfg.Name, cfg.Version, DefaultResolveInterval)
 
-	return grpc.Dial(addressAndPort)
+		return grpc.Dial(builder.target(), append(plugin.DialOptions(), creds, grpc.WithResolvers(builder), grpc.WithAuthority(addressAndPort))...)
+	}
 }
 
 type Plugin struct {
@@ -33,6 +70,8 @@ type Plugin struct {
 	logger            logger.Logger
 	discovery         Discovery
 	connectionFactory ConnectionFactory
+	// services are the grpc services registered by the plugin, nil when the plugin doesn't register the reflection service
+	services map[string]bool
 }
 
 func NewPlugin(logger logger.Logger, cfg plugin.Config, discovery Discovery, factory ConnectionFactory) plugin.Plugin {
@@ -61,14 +100,105 @@ func (p *Plugin) Init(m configuration.Metadata) error {
 	}
 
 	p.connection = conn
+
+	services, err := plugin.ListServices(conn)
+	if err != nil {
+		p.logger.Debugf("unable to list the services of plugin %s version %s, assuming it serves all components: %s", p.cfg.Name, p.cfg.Version, err)
+	}
+	p.services = services
+
+	// the plugin process receives the metadata of the plugin resource before its components are initialized
+	if p.serves(pluginproto.Plugin_ServiceDesc.ServiceName) {
+		client := pluginsdk.NewGRPCClient(pluginproto.NewPluginClient(conn))
+		client.SetTimeout(p.cfg.Timeout)
+		if err := client.Init(m); err != nil {
+			return fmt.Errorf("plugin %s version %s failed to initialize: %w", p.cfg.Name, p.cfg.Version, err)
+		}
+	}
 	return nil
 }
 
+// serves returns true if the plugin serves the grpc service or if its services are unknown.
+func (p *Plugin) serves(service string) bool {
+	return p.services == nil || p.services[service]
+}
+
 func (p *Plugin) Store() (state.Store, error) {
-	client := stateproto.NewStoreClient(p.connection)
-	return statesdk.NewGRPCClient(client), nil
+	if !p.serves(stateproto.Store_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := statesdk.NewGRPCClient(stateproto.NewStoreClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return statesdk.NewStore(client)
 }
 
 func (p *Plugin) PubSub() (pubsub.PubSub, error) {
-	return nil, nil
+	if !p.serves(pubsubproto.PubSub_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := pubsubsdk.NewGRPCClient(pubsubproto.NewPubSubClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) InputBinding() (bindings.InputBinding, error) {
+	if !p.serves(bindingsproto.InputBinding_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := bindingssdk.NewGRPCInputClient(bindingsproto.NewInputBindingClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) OutputBinding() (bindings.OutputBinding, error) {
+	if !p.serves(bindingsproto.OutputBinding_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := bindingssdk.NewGRPCOutputClient(bindingsproto.NewOutputBindingClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) SecretStore() (secretstores.SecretStore, error) {
+	if !p.serves(secretstoresproto.SecretStore_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := secretstoressdk.NewGRPCClient(secretstoresproto.NewSecretStoreClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) ConfigurationStore() (configuration.Store, error) {
+	if !p.serves(configurationproto.ConfigurationStore_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := configurationsdk.NewGRPCClient(configurationproto.NewConfigurationStoreClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) HTTPMiddleware() (middleware.Middleware, error) {
+	if !p.serves(middlewareproto.HTTPMiddleware_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := middlewaresdk.NewGRPCClient(middlewareproto.NewHTTPMiddlewareClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+func (p *Plugin) NameResolver() (nameresolution.Resolver, error) {
+	if !p.serves(nameresolutionproto.Resolver_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := nameresolutionsdk.NewGRPCClient(nameresolutionproto.NewResolverClient(p.connection))
+	client.SetTimeout(p.cfg.Timeout)
+	return client, nil
+}
+
+// Close closes the connection to the plugin.
+func (p *Plugin) Close() error {
+	if p.connection == nil {
+		return nil
+	}
+	return p.connection.Close()
 }
@@ -2,34 +2,78 @@ package kubernetes_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/nameresolution"
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/env"
 	"github.com/dapr/dapr/pkg/plugin"
 	"github.com/dapr/dapr/pkg/plugin/kubernetes"
+	bindingsproto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	configurationproto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	middlewareproto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+	nameresolutionproto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	pluginproto "github.com/dapr/dapr/pkg/proto/plugin/v1"
+	pubsubproto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	secretstoresproto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
 	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	sdk_bindings "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	sdk_configuration "github.com/dapr/dapr/pkg/sdk/configuration/v1"
+	sdk_middleware "github.com/dapr/dapr/pkg/sdk/middleware/v1"
+	sdk_nameresolution "github.com/dapr/dapr/pkg/sdk/nameresolution/v1"
+	sdk_plugin "github.com/dapr/dapr/pkg/sdk/plugin/v1"
+	sdk_pubsub "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
+	sdk_secretstores "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
 	sdk_state "github.com/dapr/dapr/pkg/sdk/state/v1"
+	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
 )
 
 // creates the dialer function for initializing a grpc connection with a dial context
 // see: http://www.inanzzz.com/index.php/post/w9qr/unit-testing-golang-grpc-client-and-server-application-with-bufconn-package
-func dialer() func(ctx context.Context, s string) (net.Conn, error) {
+func dialer(impl state.Store) func(ctx context.Context, s string) (net.Conn, error) {
+	return dialerWithConfiguration(impl, plugin.NewMemoryConfigurationStore())
+}
+
+func dialerWithConfiguration(impl state.Store, configurationStore configuration.Store) func(ctx context.Context, s string) (net.Conn, error) {
 	listener := bufconn.Listen(1024 * 1024)
 	server := grpc.NewServer()
 	store := &sdk_state.GRPCServer{
-		Impl: plugin.NewMemoryStore(),
+		Impl: impl,
 	}
 	stateproto.RegisterStoreServer(server, store)
+	pubSub := &sdk_pubsub.GRPCServer{
+		Impl: plugin.NewMemoryPubSub(),
+	}
+	pubsubproto.RegisterPubSubServer(server, pubSub)
+	binding := plugin.NewMemoryBinding()
+	bindingsproto.RegisterInputBindingServer(server, &sdk_bindings.GRPCInputServer{Impl: binding})
+	bindingsproto.RegisterOutputBindingServer(server, &sdk_bindings.GRPCOutputServer{Impl: binding})
+	secretstoresproto.RegisterSecretStoreServer(server, &sdk_secretstores.GRPCServer{Impl: daprt.FakeSecretStore{}})
+	configurationproto.RegisterConfigurationStoreServer(server, &sdk_configuration.GRPCServer{Impl: configurationStore})
 	go func() {
 		if err := server.Serve(listener); err != nil {
 			log.Fatal(err)
@@ -42,8 +86,14 @@ func dialer() func(ctx context.Context, s string) (net.Conn, error) {
 }
 
 func MockConnectionFactory(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
-	ctx := context.Background()
-	return grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer()))
+	return connectionFactory(plugin.NewMemoryStore())(metadata)
+}
+
+func connectionFactory(store state.Store) kubernetes.ConnectionFactory {
+	return func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+		ctx := context.Background()
+		return grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(dialer(store)))
+	}
 }
 
 func TestStorePlugin(t *testing.T) {
@@ -55,7 +105,7 @@ func TestStorePlugin(t *testing.T) {
 		Version: ComponentVersion,
 	}
 	environment := env.NewMemory()
-	environment.Set("DAPR_PLUGIN_TEST", fmt.Sprintf("name: %s|version: %s|ip: 192.168.1.1|port: 9999", ComponentName, ComponentVersion))
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", fmt.Sprintf("name: %s|version: %s|address: 192.168.1.1|port: 9999", ComponentName, ComponentVersion))
 	discovery := kubernetes.NewDiscovery(environment)
 	p := kubernetes.NewPlugin(logger, cfg, discovery, MockConnectionFactory)
 	err := p.Init(configuration.Metadata{})
@@ -92,3 +142,982 @@ func TestStorePlugin(t *testing.T) {
 		require.Equal(t, TestData, string(response.Data))
 	})
 }
+
+func TestPubSubPlugin(t *testing.T) {
+	const ComponentName = "test"
+	const ComponentVersion = "v1"
+	logger := logger.NewLogger("test")
+	cfg := plugin.Config{
+		Name:    ComponentName,
+		Version: ComponentVersion,
+	}
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", fmt.Sprintf("name: %s|version: %s|address: 192.168.1.1|port: 9999", ComponentName, ComponentVersion))
+	discovery := kubernetes.NewDiscovery(environment)
+	p := kubernetes.NewPlugin(logger, cfg, discovery, MockConnectionFactory)
+	err := p.Init(configuration.Metadata{})
+	require.Nil(t, err)
+
+	pubSub, err := p.PubSub()
+	require.Nil(t, err)
+	require.NotNil(t, pubSub)
+	err = pubSub.Init(pubsub.Metadata{})
+	require.Nil(t, err)
+	defer pubSub.Close()
+
+	t.Run("published messages are delivered to subscribers", func(t *testing.T) {
+		received := make(chan *pubsub.NewMessage, 1)
+		err := pubSub.Subscribe(pubsub.SubscribeRequest{
+			Topic: "orders",
+		}, func(ctx context.Context, msg *pubsub.NewMessage) error {
+			received <- msg
+			return nil
+		})
+		require.Nil(t, err)
+
+		err = pubSub.Publish(&pubsub.PublishRequest{
+			Data:     []byte("data"),
+			Topic:    "orders",
+			Metadata: map[string]string{"key": "value"},
+		})
+		require.Nil(t, err)
+
+		msg := <-received
+		require.Equal(t, "orders", msg.Topic)
+		require.Equal(t, []byte("data"), msg.Data)
+		require.Equal(t, "value", msg.Metadata["key"])
+	})
+	t.Run("subscriber errors are returned to the publisher", func(t *testing.T) {
+		err := pubSub.Subscribe(pubsub.SubscribeRequest{
+			Topic: "failures",
+		}, func(ctx context.Context, msg *pubsub.NewMessage) error {
+			return fmt.Errorf("handler failed")
+		})
+		require.Nil(t, err)
+
+		err = pubSub.Publish(&pubsub.PublishRequest{
+			Data:  []byte("data"),
+			Topic: "failures",
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "handler failed")
+	})
+	t.Run("publish carries the context of the caller", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := sdk_pubsub.WithContext(ctx, pubSub).Publish(&pubsub.PublishRequest{
+			Data:  []byte("data"),
+			Topic: "orders",
+		})
+		require.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
+
+// contextlessPubSub hides the SubscribeWithContext method of the memory pubsub.
+type contextlessPubSub struct {
+	pubsub.PubSub
+}
+
+func TestPubSubSubscriptionEndsWithStream(t *testing.T) {
+	newClient := func(pubSubServer pubsubproto.PubSubServer) *sdk_pubsub.GRPCClient {
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		pubsubproto.RegisterPubSubServer(server, pubSubServer)
+		go server.Serve(listener)
+		t.Cleanup(server.Stop)
+
+		conn, err := grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+		require.Nil(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return sdk_pubsub.NewGRPCClient(pubsubproto.NewPubSubClient(conn))
+	}
+	subscribe := func(client *sdk_pubsub.GRPCClient) chan *pubsub.NewMessage {
+		received := make(chan *pubsub.NewMessage, 1)
+		err := client.Subscribe(pubsub.SubscribeRequest{
+			Topic: "orders",
+		}, func(ctx context.Context, msg *pubsub.NewMessage) error {
+			received <- msg
+			return nil
+		})
+		require.Nil(t, err)
+		return received
+	}
+
+	t.Run("the subscription is removed from a context subscriber", func(t *testing.T) {
+		memoryPubSub := plugin.NewMemoryPubSub()
+		client := newClient(&sdk_pubsub.GRPCServer{Impl: memoryPubSub})
+		subscribe(client)
+		require.Equal(t, 1, memoryPubSub.Subscribers("orders"))
+
+		client.Close()
+		require.Eventually(t, func() bool {
+			return memoryPubSub.Subscribers("orders") == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("the handler of another pubsub stops delivering", func(t *testing.T) {
+		memoryPubSub := plugin.NewMemoryPubSub()
+		client := newClient(&sdk_pubsub.GRPCServer{Impl: contextlessPubSub{memoryPubSub}})
+		subscribe(client)
+
+		client.Close()
+		require.Eventually(t, func() bool {
+			err := memoryPubSub.Publish(&pubsub.PublishRequest{Topic: "orders", Data: []byte("data")})
+			return errors.Is(err, sdk_pubsub.ErrSubscriptionClosed)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("another pubsub is subscribed once and delivers to the current stream", func(t *testing.T) {
+		memoryPubSub := plugin.NewMemoryPubSub()
+		server := &sdk_pubsub.GRPCServer{Impl: contextlessPubSub{memoryPubSub}}
+		closed := newClient(server)
+		subscribe(closed)
+		closed.Close()
+
+		client := newClient(server)
+		defer client.Close()
+		received := subscribe(client)
+		require.Equal(t, 1, memoryPubSub.Subscribers("orders"))
+
+		require.Nil(t, memoryPubSub.Publish(&pubsub.PublishRequest{Topic: "orders", Data: []byte("data")}))
+		msg := <-received
+		require.Equal(t, []byte("data"), msg.Data)
+	})
+
+	t.Run("a broken stream is subscribed again", func(t *testing.T) {
+		memoryPubSub := plugin.NewMemoryPubSub()
+		client := newClient(&brokenPubSubServer{GRPCServer: &sdk_pubsub.GRPCServer{Impl: memoryPubSub}})
+		defer client.Close()
+		received := subscribe(client)
+
+		require.Eventually(t, func() bool {
+			return memoryPubSub.Subscribers("orders") == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Nil(t, memoryPubSub.Publish(&pubsub.PublishRequest{Topic: "orders", Data: []byte("data")}))
+		msg := <-received
+		require.Equal(t, []byte("data"), msg.Data)
+	})
+}
+
+// brokenPubSubServer breaks the first subscription stream right after accepting the subscription.
+type brokenPubSubServer struct {
+	*sdk_pubsub.GRPCServer
+	broken int32
+}
+
+func (s *brokenPubSubServer) Subscribe(stream pubsubproto.PubSub_SubscribeServer) error {
+	if !atomic.CompareAndSwapInt32(&s.broken, 0, 1) {
+		return s.GRPCServer.Subscribe(stream)
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	return status.Error(codes.Unavailable, "the stream broke")
+}
+
+func TestBindingsPlugin(t *testing.T) {
+	const ComponentName = "test"
+	const ComponentVersion = "v1"
+	cfg := plugin.Config{
+		Name:    ComponentName,
+		Version: ComponentVersion,
+	}
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", fmt.Sprintf("name: %s|version: %s|address: 192.168.1.1|port: 9999", ComponentName, ComponentVersion))
+	discovery := kubernetes.NewDiscovery(environment)
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), cfg, discovery, MockConnectionFactory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	output, err := p.OutputBinding()
+	require.Nil(t, err)
+	require.Nil(t, output.Init(bindings.Metadata{Name: "binding"}))
+	input, err := p.InputBinding()
+	require.Nil(t, err)
+	require.Nil(t, input.Init(bindings.Metadata{Name: "binding"}))
+	defer input.(*sdk_bindings.GRPCInputClient).Close()
+
+	t.Run("operations are returned", func(t *testing.T) {
+		require.Equal(t, []bindings.OperationKind{bindings.CreateOperation}, output.Operations())
+	})
+	t.Run("unsupported operations fail", func(t *testing.T) {
+		_, err := output.Invoke(&bindings.InvokeRequest{Operation: bindings.DeleteOperation})
+		require.Error(t, err)
+	})
+
+	handlerErr := fmt.Errorf("handler failed")
+	received := make(chan *bindings.ReadResponse, 1)
+	go input.Read(func(resp *bindings.ReadResponse) ([]byte, error) {
+		received <- resp
+		if string(resp.Data) == "fail" {
+			return nil, handlerErr
+		}
+		return []byte("response"), nil
+	})
+
+	t.Run("read events are answered by the handler", func(t *testing.T) {
+		var resp *bindings.InvokeResponse
+		// the output binding fails until the plugin reads the input binding
+		require.Eventually(t, func() bool {
+			resp, err = output.Invoke(&bindings.InvokeRequest{
+				Data:      []byte("data"),
+				Metadata:  map[string]string{"key": "value"},
+				Operation: bindings.CreateOperation,
+			})
+			return err == nil
+		}, time.Second*5, time.Millisecond*10)
+		require.Equal(t, []byte("response"), resp.Data)
+		require.Equal(t, "value", resp.Metadata["key"])
+
+		event := <-received
+		require.Equal(t, []byte("data"), event.Data)
+		require.Equal(t, "value", event.Metadata["key"])
+	})
+	t.Run("handler errors are returned to the binding", func(t *testing.T) {
+		_, err := output.Invoke(&bindings.InvokeRequest{
+			Data:      []byte("fail"),
+			Operation: bindings.CreateOperation,
+		})
+		<-received
+		require.Error(t, err)
+		require.Contains(t, err.Error(), handlerErr.Error())
+	})
+}
+
+func TestBindingsReadStreamIsOpenedAgain(t *testing.T) {
+	binding := plugin.NewMemoryBinding()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	bindingsproto.RegisterInputBindingServer(server, &brokenInputBindingServer{GRPCInputServer: &sdk_bindings.GRPCInputServer{Impl: binding}})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.Nil(t, err)
+	defer conn.Close()
+
+	input := sdk_bindings.NewGRPCInputClient(bindingsproto.NewInputBindingClient(conn))
+	defer input.Close()
+	received := make(chan *bindings.ReadResponse, 1)
+	go input.Read(func(resp *bindings.ReadResponse) ([]byte, error) {
+		received <- resp
+		return nil, nil
+	})
+
+	// the binding fails until the plugin reads it again on a new stream
+	require.Eventually(t, func() bool {
+		_, err = binding.Invoke(&bindings.InvokeRequest{
+			Data:      []byte("data"),
+			Operation: bindings.CreateOperation,
+		})
+		return err == nil
+	}, time.Second*5, time.Millisecond*10)
+	event := <-received
+	require.Equal(t, []byte("data"), event.Data)
+}
+
+// brokenInputBindingServer breaks the first read stream.
+type brokenInputBindingServer struct {
+	*sdk_bindings.GRPCInputServer
+	broken int32
+}
+
+func (s *brokenInputBindingServer) Read(stream bindingsproto.InputBinding_ReadServer) error {
+	if !atomic.CompareAndSwapInt32(&s.broken, 0, 1) {
+		return s.GRPCInputServer.Read(stream)
+	}
+	return status.Error(codes.Unavailable, "the stream broke")
+}
+
+func TestSecretStorePlugin(t *testing.T) {
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	discovery := kubernetes.NewDiscovery(environment)
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, discovery, MockConnectionFactory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	store, err := p.SecretStore()
+	require.Nil(t, err)
+	require.Nil(t, store.Init(secretstores.Metadata{}))
+
+	t.Run("get secret", func(t *testing.T) {
+		resp, err := store.GetSecret(secretstores.GetSecretRequest{Name: "good-key"})
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"good-key": "life is good"}, resp.Data)
+	})
+	t.Run("get secret error", func(t *testing.T) {
+		_, err := store.GetSecret(secretstores.GetSecretRequest{Name: "error-key"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "error occurs with error-key")
+	})
+	t.Run("bulk get secret", func(t *testing.T) {
+		resp, err := store.BulkGetSecret(secretstores.BulkGetSecretRequest{})
+		require.Nil(t, err)
+		require.Equal(t, map[string]map[string]string{"good-key": {"good-key": "life is good"}}, resp.Data)
+	})
+	t.Run("get secret carries the context of the caller", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := sdk_secretstores.WithContext(ctx, store).GetSecret(secretstores.GetSecretRequest{Name: "good-key"})
+		require.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
+
+func TestConfigurationStorePlugin(t *testing.T) {
+	impl := plugin.NewMemoryConfigurationStore()
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+		return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(dialerWithConfiguration(plugin.NewMemoryStore(), impl)))
+	}
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	store, err := p.ConfigurationStore()
+	require.Nil(t, err)
+	require.Nil(t, store.Init(configuration.Metadata{}))
+	defer store.(*sdk_configuration.GRPCClient).Close()
+
+	impl.Set(&configuration.Item{Key: "key1", Value: "val1", Version: "1", Metadata: map[string]string{"k": "v"}})
+
+	t.Run("get returns the items", func(t *testing.T) {
+		resp, err := store.Get(context.Background(), &configuration.GetRequest{Keys: []string{"key1", "missing"}})
+		require.Nil(t, err)
+		require.Len(t, resp.Items, 1)
+		require.Equal(t, &configuration.Item{Key: "key1", Value: "val1", Version: "1", Metadata: map[string]string{"k": "v"}}, resp.Items[0])
+	})
+
+	t.Run("updates are streamed to subscribers", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := make(chan *configuration.UpdateEvent, 1)
+		err := store.Subscribe(ctx, &configuration.SubscribeRequest{Keys: []string{"key2"}}, func(ctx context.Context, e *configuration.UpdateEvent) error {
+			updates <- e
+			return nil
+		})
+		require.Nil(t, err)
+
+		impl.Set(&configuration.Item{Key: "key1", Value: "ignored"})
+		impl.Set(&configuration.Item{Key: "key2", Value: "val2"})
+		select {
+		case e := <-updates:
+			require.Len(t, e.Items, 1)
+			require.Equal(t, "key2", e.Items[0].Key)
+			require.Equal(t, "val2", e.Items[0].Value)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "update not received")
+		}
+	})
+
+	t.Run("subscriptions are removed when their context is cancelled", func(t *testing.T) {
+		client := store.(*sdk_configuration.GRPCClient)
+		// the subscription of the previous test was cancelled
+		require.Eventually(t, func() bool {
+			return client.Subscriptions() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		err := store.Subscribe(ctx, &configuration.SubscribeRequest{Keys: []string{"key3"}}, func(ctx context.Context, e *configuration.UpdateEvent) error {
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, client.Subscriptions())
+
+		cancel()
+		require.Eventually(t, func() bool {
+			return client.Subscriptions() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestConfigurationSubscriptionIsOpenedAgain(t *testing.T) {
+	impl := plugin.NewMemoryConfigurationStore()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	configurationproto.RegisterConfigurationStoreServer(server, &brokenConfigurationStoreServer{GRPCServer: &sdk_configuration.GRPCServer{Impl: impl}})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.Nil(t, err)
+	defer conn.Close()
+
+	client := sdk_configuration.NewGRPCClient(configurationproto.NewConfigurationStoreClient(conn))
+	defer client.Close()
+	updates := make(chan *configuration.UpdateEvent, 1)
+	err = client.Subscribe(context.Background(), &configuration.SubscribeRequest{Keys: []string{"key1"}}, func(ctx context.Context, e *configuration.UpdateEvent) error {
+		updates <- e
+		return nil
+	})
+	require.Nil(t, err)
+
+	// the update is only delivered once the plugin is subscribed again on a new stream
+	require.Eventually(t, func() bool {
+		impl.Set(&configuration.Item{Key: "key1", Value: "val1"})
+		select {
+		case e := <-updates:
+			return e.Items[0].Value == "val1"
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, client.Subscriptions())
+}
+
+// brokenConfigurationStoreServer breaks the first subscription stream right after accepting the subscription.
+type brokenConfigurationStoreServer struct {
+	*sdk_configuration.GRPCServer
+	broken int32
+}
+
+func (s *brokenConfigurationStoreServer) Subscribe(req *configurationproto.SubscribeRequest, stream configurationproto.ConfigurationStore_SubscribeServer) error {
+	if !atomic.CompareAndSwapInt32(&s.broken, 0, 1) {
+		return s.GRPCServer.Subscribe(req, stream)
+	}
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	return status.Error(codes.Unavailable, "the stream broke")
+}
+
+func TestHTTPMiddlewarePlugin(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	middlewareproto.RegisterHTTPMiddlewareServer(server, &sdk_middleware.GRPCServer{Impl: plugin.NewHeaderMiddleware()})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+		return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	}
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	m, err := p.HTTPMiddleware()
+	require.Nil(t, err)
+	handler, err := m.GetHandler(middleware.Metadata{Properties: map[string]string{"header": "X-Plugin", "value": "test"}})
+	require.Nil(t, err)
+
+	var next *fasthttp.Request
+	h := handler(func(ctx *fasthttp.RequestCtx) {
+		next = &fasthttp.Request{}
+		ctx.Request.CopyTo(next)
+		ctx.SetStatusCode(fasthttp.StatusAccepted)
+	})
+
+	t.Run("changed request is passed on", func(t *testing.T) {
+		next = nil
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&fasthttp.Request{}, nil, nil)
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetRequestURI("/v1.0/state/store?metadata.k=v")
+		ctx.Request.Header.Set("X-Existing", "value")
+		ctx.Request.SetBody([]byte("body"))
+		h(&ctx)
+
+		require.NotNil(t, next)
+		require.Equal(t, fasthttp.MethodPost, string(next.Header.Method()))
+		require.Equal(t, "/v1.0/state/store?metadata.k=v", string(next.Header.RequestURI()))
+		require.Equal(t, "value", string(next.Header.Peek("X-Existing")))
+		require.Equal(t, "test", string(next.Header.Peek("X-Plugin")))
+		require.Equal(t, "body", string(next.Body()))
+		require.Equal(t, fasthttp.StatusAccepted, ctx.Response.StatusCode())
+	})
+
+	t.Run("plugin response is returned", func(t *testing.T) {
+		next = nil
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&fasthttp.Request{}, nil, nil)
+		ctx.Request.SetRequestURI("/v1.0/state/store")
+		ctx.Request.Header.Set(plugin.DenyHeader, "true")
+		h(&ctx)
+
+		require.Nil(t, next)
+		require.Equal(t, fasthttp.StatusForbidden, ctx.Response.StatusCode())
+		require.Equal(t, "denied", string(ctx.Response.Body()))
+	})
+}
+
+// replyMiddlewareServer replies to every request with a fixed handle response
+type replyMiddlewareServer struct {
+	middlewareproto.UnimplementedHTTPMiddlewareServer
+	reply *middlewareproto.HandleResponse
+}
+
+func (s *replyMiddlewareServer) Init(ctx context.Context, req *middlewareproto.MetadataRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (s *replyMiddlewareServer) Handle(ctx context.Context, req *middlewareproto.HTTPRequest) (*middlewareproto.HandleResponse, error) {
+	return s.reply, nil
+}
+
+func TestHTTPMiddlewarePluginReplies(t *testing.T) {
+	newHandler := func(t *testing.T, reply *middlewareproto.HandleResponse) fasthttp.RequestHandler {
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		middlewareproto.RegisterHTTPMiddlewareServer(server, &replyMiddlewareServer{reply: reply})
+		go server.Serve(listener)
+		t.Cleanup(server.Stop)
+
+		environment := env.NewMemory()
+		environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+		factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+			return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+				return listener.Dial()
+			}))
+		}
+		p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+		require.Nil(t, p.Init(configuration.Metadata{}))
+
+		m, err := p.HTTPMiddleware()
+		require.Nil(t, err)
+		handler, err := m.GetHandler(middleware.Metadata{})
+		require.Nil(t, err)
+		return handler(func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusAccepted)
+			ctx.SetBody(ctx.Request.Body())
+		})
+	}
+
+	t.Run("content length of any case is not copied", func(t *testing.T) {
+		h := newHandler(t, &middlewareproto.HandleResponse{
+			Result: &middlewareproto.HandleResponse_Next{
+				Next: &middlewareproto.HTTPRequest{
+					Method:  fasthttp.MethodPost,
+					Uri:     "/v1.0/state/store",
+					Headers: []*middlewareproto.Header{{Key: "content-length", Value: "100"}},
+					Body:    []byte("body"),
+				},
+			},
+		})
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&fasthttp.Request{}, nil, nil)
+		h(&ctx)
+
+		require.Equal(t, fasthttp.StatusAccepted, ctx.Response.StatusCode())
+		require.NotEqual(t, 100, ctx.Request.Header.ContentLength())
+		require.Equal(t, "body", string(ctx.Response.Body()))
+	})
+	t.Run("reply without next request nor response is an error", func(t *testing.T) {
+		h := newHandler(t, &middlewareproto.HandleResponse{})
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&fasthttp.Request{}, nil, nil)
+		h(&ctx)
+
+		require.Equal(t, fasthttp.StatusInternalServerError, ctx.Response.StatusCode())
+		require.Contains(t, string(ctx.Response.Body()), "error calling middleware plugin")
+	})
+}
+
+func TestNameResolverPlugin(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	nameresolutionproto.RegisterResolverServer(server, &sdk_nameresolution.GRPCServer{Impl: plugin.NewStaticResolver()})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+		return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	}
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	resolver, err := p.NameResolver()
+	require.Nil(t, err)
+	// configuration read from yaml files has maps with interface keys
+	err = resolver.Init(nameresolution.Metadata{
+		Configuration: map[interface{}]interface{}{"app1": "10.0.0.1:50002"},
+	})
+	require.Nil(t, err)
+
+	address, err := resolver.ResolveID(nameresolution.ResolveRequest{ID: "app1", Namespace: "default", Port: 50002})
+	require.Nil(t, err)
+	require.Equal(t, "10.0.0.1:50002", address)
+
+	_, err = resolver.ResolveID(nameresolution.ResolveRequest{ID: "app2"})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "app id app2 not found")
+}
+
+type initializer struct {
+	metadata configuration.Metadata
+}
+
+func (i *initializer) Init(metadata configuration.Metadata) error {
+	i.metadata = metadata
+	return nil
+}
+
+func TestPluginInit(t *testing.T) {
+	newPlugin := func(register func(*grpc.Server)) plugin.Plugin {
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		register(server)
+		reflection.Register(server)
+		go server.Serve(listener)
+		t.Cleanup(server.Stop)
+
+		environment := env.NewMemory()
+		environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+		factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+			return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+				return listener.Dial()
+			}))
+		}
+		return kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+	}
+
+	t.Run("metadata is passed to the plugin process", func(t *testing.T) {
+		impl := &initializer{}
+		p := newPlugin(func(s *grpc.Server) {
+			pluginproto.RegisterPluginServer(s, &sdk_plugin.GRPCServer{Impl: impl})
+		})
+		require.Nil(t, p.Init(configuration.Metadata{Properties: map[string]string{"key": "value"}}))
+		require.Equal(t, map[string]string{"key": "value"}, impl.metadata.Properties)
+	})
+
+	t.Run("plugin process without the plugin service", func(t *testing.T) {
+		p := newPlugin(func(s *grpc.Server) {
+			stateproto.RegisterStoreServer(s, &sdk_state.GRPCServer{Impl: plugin.NewMemoryStore()})
+		})
+		require.Nil(t, p.Init(configuration.Metadata{Properties: map[string]string{"key": "value"}}))
+	})
+
+	t.Run("close closes the connection to the plugin", func(t *testing.T) {
+		p := newPlugin(func(s *grpc.Server) {
+			stateproto.RegisterStoreServer(s, &sdk_state.GRPCServer{Impl: plugin.NewMemoryStore()})
+		})
+		require.Nil(t, p.Init(configuration.Metadata{}))
+		closer, ok := p.(io.Closer)
+		require.True(t, ok)
+		require.Nil(t, closer.Close())
+		// the connection is already closed
+		require.NotNil(t, closer.Close())
+	})
+}
+
+func TestPluginServices(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	stateproto.RegisterStoreServer(server, &sdk_state.GRPCServer{Impl: plugin.NewMemoryStore()})
+	reflection.Register(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", "name: test|version: v1|address: 192.168.1.1|port: 9999")
+	factory := func(metadata *kubernetes.Metadata) (*grpc.ClientConn, error) {
+		return grpc.Dial("", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	}
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), plugin.Config{Name: "test", Version: "v1"}, kubernetes.NewDiscovery(environment), factory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	_, err := p.Store()
+	require.Nil(t, err)
+	_, err = p.PubSub()
+	require.Equal(t, plugin.ErrComponentNotImplemented, err)
+	_, err = p.InputBinding()
+	require.Equal(t, plugin.ErrComponentNotImplemented, err)
+	_, err = p.OutputBinding()
+	require.Equal(t, plugin.ErrComponentNotImplemented, err)
+	_, err = p.SecretStore()
+	require.Equal(t, plugin.ErrComponentNotImplemented, err)
+	_, err = p.ConfigurationStore()
+	require.Equal(t, plugin.ErrComponentNotImplemented, err)
+}
+
+// newStorePlugin initializes a state store served by the given implementation over the plugin protocol
+func newStorePlugin(t *testing.T, impl state.Store) state.Store {
+	return newStorePluginWithTimeout(t, impl, 0)
+}
+
+func newStorePluginWithTimeout(t *testing.T, impl state.Store, timeout time.Duration) state.Store {
+	const ComponentName = "test"
+	const ComponentVersion = "v1"
+	cfg := plugin.Config{
+		Name:    ComponentName,
+		Version: ComponentVersion,
+		Timeout: timeout,
+	}
+	environment := env.NewMemory()
+	environment.Set("DAPR_PLUGIN_ENDPOINT_TEST", fmt.Sprintf("name: %s|version: %s|address: 192.168.1.1|port: 9999", ComponentName, ComponentVersion))
+	discovery := kubernetes.NewDiscovery(environment)
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), cfg, discovery, connectionFactory(impl))
+	require.Nil(t, p.Init(configuration.Metadata{}))
+	store, err := p.Store()
+	require.Nil(t, err)
+	require.Nil(t, store.Init(state.Metadata{}))
+	return store
+}
+
+func TestTransactionalStorePlugin(t *testing.T) {
+	t.Run("store is not transactional when the plugin is not transactional", func(t *testing.T) {
+		store := newStorePlugin(t, plugin.NewMemoryStore())
+		require.False(t, state.FeatureTransactional.IsPresent(store.Features()))
+
+		_, ok := store.(state.TransactionalStore)
+		require.False(t, ok)
+	})
+	t.Run("multi applies the operations", func(t *testing.T) {
+		store := newStorePlugin(t, plugin.NewTransactionalMemoryStore())
+		require.True(t, state.FeatureTransactional.IsPresent(store.Features()))
+
+		require.Nil(t, store.Set(&state.SetRequest{Key: "deleted", Value: "data"}))
+		err := store.(state.TransactionalStore).Multi(&state.TransactionalStateRequest{
+			Operations: []state.TransactionalStateOperation{
+				{
+					Operation: state.Upsert,
+					Request:   state.SetRequest{Key: "upserted", Value: "data"},
+				},
+				{
+					Operation: state.Delete,
+					Request:   state.DeleteRequest{Key: "deleted"},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		response, err := store.Get(&state.GetRequest{Key: "upserted"})
+		require.Nil(t, err)
+		require.Equal(t, "data", string(response.Data))
+		response, err = store.Get(&state.GetRequest{Key: "deleted"})
+		require.Nil(t, err)
+		require.Nil(t, response.Data)
+	})
+}
+
+// queryStore records the query it receives and returns a single result
+type queryStore struct {
+	*plugin.MemoryStore
+	received *state.QueryRequest
+}
+
+func (s *queryStore) Query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.received = req
+	etag := "1"
+	return &state.QueryResponse{
+		Results: []state.QueryItem{
+			{
+				Key:  "key",
+				Data: []byte(`{"a":"b"}`),
+				ETag: &etag,
+			},
+		},
+		Token: "next",
+	}, nil
+}
+
+func TestQueryStorePlugin(t *testing.T) {
+	var req state.QueryRequest
+	err := json.Unmarshal([]byte(`{
+		"filter": {
+			"OR": [
+				{"EQ": {"state": "CA"}},
+				{"AND": [
+					{"EQ": {"person.org": "Dev Ops"}},
+					{"IN": {"state": ["WA", "OR"]}}
+				]}
+			]
+		},
+		"sort": [{"key": "state", "order": "DESC"}],
+		"page": {"limit": 2, "token": "start"}
+	}`), &req.Query)
+	require.Nil(t, err)
+	req.Metadata = map[string]string{"contentType": "application/json"}
+
+	t.Run("query roundtrips through the plugin", func(t *testing.T) {
+		impl := &queryStore{MemoryStore: plugin.NewMemoryStore()}
+		store := newStorePlugin(t, impl)
+		querier, ok := store.(state.Querier)
+		require.True(t, ok)
+		_, ok = store.(state.TransactionalStore)
+		require.False(t, ok)
+
+		response, err := querier.Query(&req)
+		require.Nil(t, err)
+		require.Len(t, response.Results, 1)
+		require.Equal(t, "key", response.Results[0].Key)
+		require.Equal(t, `{"a":"b"}`, string(response.Results[0].Data))
+		require.Equal(t, "1", *response.Results[0].ETag)
+		require.Equal(t, "next", response.Token)
+
+		require.NotNil(t, impl.received)
+		require.Equal(t, req.Query.Filter, impl.received.Query.Filter)
+		require.Equal(t, req.Query.Sort, impl.received.Query.Sort)
+		require.Equal(t, req.Query.Page, impl.received.Query.Page)
+		require.Equal(t, req.Metadata, impl.received.Metadata)
+	})
+	t.Run("query is exposed by a transactional plugin store", func(t *testing.T) {
+		impl := &queryStore{MemoryStore: plugin.NewTransactionalMemoryStore()}
+		store := newStorePlugin(t, impl)
+		_, ok := store.(state.TransactionalStore)
+		require.True(t, ok)
+		querier, ok := store.(state.Querier)
+		require.True(t, ok)
+
+		response, err := querier.Query(&req)
+		require.Nil(t, err)
+		require.Equal(t, "next", response.Token)
+	})
+	t.Run("query is not exposed when the plugin store is not a querier", func(t *testing.T) {
+		store := newStorePlugin(t, plugin.NewMemoryStore())
+		_, ok := store.(state.Querier)
+		require.False(t, ok)
+	})
+}
+
+// etagStore keeps a fixed etag for every key and records the options of the last request
+type etagStore struct {
+	*plugin.MemoryStore
+	getOptions state.GetStateOption
+	setOptions state.SetStateOption
+}
+
+func (s *etagStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.getOptions = req.Options
+	response, err := s.MemoryStore.Get(req)
+	if err != nil || response.Data == nil {
+		return response, err
+	}
+	etag := "1"
+	response.ETag = &etag
+	return response, nil
+}
+
+func (s *etagStore) Set(req *state.SetRequest) error {
+	s.setOptions = req.Options
+	if req.ETag != nil && *req.ETag != "1" {
+		return state.NewETagError(state.ETagMismatch, fmt.Errorf("etag %s does not match", *req.ETag))
+	}
+	return s.MemoryStore.Set(req)
+}
+
+func (s *etagStore) Delete(req *state.DeleteRequest) error {
+	if req.ETag != nil && *req.ETag == "" {
+		return state.NewETagError(state.ETagInvalid, nil)
+	}
+	return s.MemoryStore.Delete(req)
+}
+
+func TestStorePluginContract(t *testing.T) {
+	impl := &etagStore{MemoryStore: plugin.NewMemoryStore()}
+	store := newStorePlugin(t, impl)
+
+	t.Run("bulk delete removes all keys", func(t *testing.T) {
+		require.Nil(t, store.BulkSet([]state.SetRequest{
+			{Key: "a", Value: "1"},
+			{Key: "b", Value: "2"},
+		}))
+		require.Nil(t, store.BulkDelete([]state.DeleteRequest{
+			{Key: "a"},
+			{Key: "b"},
+		}))
+		for _, key := range []string{"a", "b"} {
+			response, err := store.Get(&state.GetRequest{Key: key})
+			require.Nil(t, err)
+			require.Nil(t, response.Data)
+		}
+	})
+	t.Run("delete without etag and options", func(t *testing.T) {
+		require.Nil(t, store.Delete(&state.DeleteRequest{Key: "missing"}))
+	})
+	t.Run("get returns the etag", func(t *testing.T) {
+		require.Nil(t, store.Set(&state.SetRequest{Key: "etag", Value: "data"}))
+		response, err := store.Get(&state.GetRequest{Key: "etag"})
+		require.Nil(t, err)
+		require.NotNil(t, response.ETag)
+		require.Equal(t, "1", *response.ETag)
+	})
+	t.Run("consistency and concurrency are mapped", func(t *testing.T) {
+		_, err := store.Get(&state.GetRequest{
+			Key:     "etag",
+			Options: state.GetStateOption{Consistency: state.Strong},
+		})
+		require.Nil(t, err)
+		require.Equal(t, state.Strong, impl.getOptions.Consistency)
+
+		err = store.Set(&state.SetRequest{
+			Key:   "etag",
+			Value: "data",
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+				Consistency: state.Eventual,
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, state.FirstWrite, impl.setOptions.Concurrency)
+		require.Equal(t, state.Eventual, impl.setOptions.Consistency)
+	})
+	t.Run("etag mismatch is returned as an etag error", func(t *testing.T) {
+		etag := "2"
+		err := store.Set(&state.SetRequest{Key: "etag", Value: "data", ETag: &etag})
+		etagErr, ok := err.(*state.ETagError)
+		require.True(t, ok)
+		require.Equal(t, state.ETagMismatch, etagErr.Kind())
+		require.Equal(t, "possible etag mismatch. error from state store: etag 2 does not match", etagErr.Error())
+	})
+	t.Run("invalid etag is returned as an etag error", func(t *testing.T) {
+		etag := ""
+		err := store.Delete(&state.DeleteRequest{Key: "etag", ETag: &etag})
+		etagErr, ok := err.(*state.ETagError)
+		require.True(t, ok)
+		require.Equal(t, state.ETagInvalid, etagErr.Kind())
+	})
+}
+
+// slowStore delays every read
+type slowStore struct {
+	*plugin.MemoryStore
+	delay time.Duration
+}
+
+func (s *slowStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	time.Sleep(s.delay)
+	return s.MemoryStore.Get(req)
+}
+
+func TestStorePluginContext(t *testing.T) {
+	t.Run("calls are bounded by the plugin timeout", func(t *testing.T) {
+		store := newStorePluginWithTimeout(t, &slowStore{MemoryStore: plugin.NewMemoryStore(), delay: time.Second}, 50*time.Millisecond)
+		_, err := store.Get(&state.GetRequest{Key: "key"})
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+	t.Run("calls complete within the plugin timeout", func(t *testing.T) {
+		store := newStorePluginWithTimeout(t, plugin.NewMemoryStore(), time.Second)
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+		response, err := store.Get(&state.GetRequest{Key: "key"})
+		require.Nil(t, err)
+		require.Equal(t, []byte("value"), response.Data)
+	})
+	t.Run("calls are cancelled with the request context", func(t *testing.T) {
+		store := newStorePlugin(t, plugin.NewMemoryStore())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := sdk_state.WithContext(ctx, store).Get(&state.GetRequest{Key: "key"})
+		require.Equal(t, codes.Canceled, status.Code(err))
+
+		_, err = store.Get(&state.GetRequest{Key: "key"})
+		require.Nil(t, err)
+	})
+}
@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	// resolverScheme is the grpc resolver scheme of plugin connections.
+	resolverScheme = "dapr-plugin"
+	// DefaultResolveInterval is the interval at which the endpoint of a connected plugin is resolved again.
+	DefaultResolveInterval = 30 * time.Second
+)
+
+// discoveryResolverBuilder builds grpc resolvers locating a plugin through its discovery, so the connection follows the plugin when its endpoint changes.
+type discoveryResolverBuilder struct {
+	discovery Discovery
+	name      string
+	version   string
+	interval  time.Duration
+}
+
+func newDiscoveryResolverBuilder(discovery Discovery, name, version string, interval time.Duration) *discoveryResolverBuilder {
+	return &discoveryResolverBuilder{
+		discovery: discovery,
+		name:      name,
+		version:   version,
+		interval:  interval,
+	}
+}
+
+// target returns the dial target resolved by the builder.
+func (b *discoveryResolverBuilder) target() string {
+	return fmt.Sprintf("%s:///%s/%s", resolverScheme, b.name, b.version)
+}
+
+func (b *discoveryResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	r := &discoveryResolver{
+		builder:    b,
+		cc:         cc,
+		resolveNow: make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	if err := r.resolve(); err != nil {
+		return nil, err
+	}
+	go r.watch()
+	return r, nil
+}
+
+func (b *discoveryResolverBuilder) Scheme() string {
+	return resolverScheme
+}
+
+type discoveryResolver struct {
+	builder    *discoveryResolverBuilder
+	cc         resolver.ClientConn
+	addresses  []string
+	resolveNow chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+// resolve looks up the plugin and updates the connection when its endpoints changed.
+// The endpoints come in a stable order, so the connection stays on its current endpoint while it is still listed.
+func (r *discoveryResolver) resolve() error {
+	metadata, ok, err := r.builder.discovery.Lookup(r.builder.name, r.builder.version)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("unable to locate plugin metadata for plugin %s version %s", r.builder.name, r.builder.version)
+	}
+	addresses := metadata.addresses()
+	if reflect.DeepEqual(addresses, r.addresses) {
+		return nil
+	}
+	r.addresses = addresses
+	state := resolver.State{}
+	for _, address := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: address})
+	}
+	return r.cc.UpdateState(state)
+}
+
+// watch resolves the plugin again periodically and whenever grpc asks for it, e.g. after the connection failed.
+func (r *discoveryResolver) watch() {
+	ticker := time.NewTicker(r.builder.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		case <-r.resolveNow:
+		}
+		if err := r.resolve(); err != nil {
+			r.cc.ReportError(err)
+		}
+	}
+}
+
+func (r *discoveryResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *discoveryResolver) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ServicePortName is the name of the service port serving the grpc plugin, which names its DNS SRV record.
+	ServicePortName = "grpc"
+	// serviceLookupTimeout bounds each DNS lookup of a plugin service.
+	serviceLookupTimeout = 5 * time.Second
+)
+
+var invalidServiceNameChars = regexp.MustCompile("[^a-z0-9-]+")
+
+// SRVResolver looks up DNS SRV records. It is implemented by net.Resolver.
+type SRVResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+type serviceDiscovery struct {
+	namespace string
+	resolver  SRVResolver
+}
+
+// NewServiceDiscovery returns a discovery locating plugins deployed as Kubernetes services in the namespace, through the DNS SRV record of the grpc port of the service.
+// The service of a plugin is named after the plugin and its version, e.g. my-plugin-v1, so a shared plugin deployment scales independently of the application pods.
+// The SRV targets are the pods of the service, so TLS connections verify the plugin certificate against the service name instead, see ServerName.
+func NewServiceDiscovery(namespace string, resolver SRVResolver) Discovery {
+	return &serviceDiscovery{
+		namespace: namespace,
+		resolver:  resolver,
+	}
+}
+
+func (d *serviceDiscovery) Lookup(name, version string) (*Metadata, bool, error) {
+	host := d.ServerName(name, version)
+	ctx, cancel := context.WithTimeout(context.Background(), serviceLookupTimeout)
+	defer cancel()
+	_, records, err := d.resolver.LookupSRV(ctx, ServicePortName, "tcp", host)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to look up the service of plugin %s version %s: %w", name, version, err)
+	}
+	if len(records) == 0 {
+		return nil, false, nil
+	}
+
+	// the records are randomized by weight on every lookup, so the endpoints are sorted to keep the connection on its current endpoint.
+	// only the records of the lowest priority are used.
+	priority := records[0].Priority
+	for _, record := range records {
+		if record.Priority < priority {
+			priority = record.Priority
+		}
+	}
+	preferred := []*net.SRV{}
+	for _, record := range records {
+		if record.Priority == priority {
+			preferred = append(preferred, record)
+		}
+	}
+	sort.Slice(preferred, func(i, j int) bool {
+		if preferred[i].Target != preferred[j].Target {
+			return preferred[i].Target < preferred[j].Target
+		}
+		return preferred[i].Port < preferred[j].Port
+	})
+
+	endpoints := make([]string, 0, len(preferred))
+	for _, record := range preferred {
+		endpoints = append(endpoints, net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port))))
+	}
+	return &Metadata{
+		Name:      name,
+		Version:   version,
+		Address:   strings.TrimSuffix(preferred[0].Target, "."),
+		Port:      int(preferred[0].Port),
+		Endpoints: endpoints,
+	}, true, nil
+}
+
+// ServerName returns the DNS name of the service of a plugin version, which stays the same while the endpoints of the service move.
+func (d *serviceDiscovery) ServerName(name, version string) string {
+	host := ServiceName(name, version)
+	if d.namespace != "" {
+		host = fmt.Sprintf("%s.%s.svc", host, d.namespace)
+	}
+	return host
+}
+
+// ServiceName returns the name of the Kubernetes service of a plugin version.
+func ServiceName(name, version string) string {
+	serviceName := name
+	if version != "" {
+		serviceName += "-" + version
+	}
+	return strings.Trim(invalidServiceNameChars.ReplaceAllString(strings.ToLower(serviceName), "-"), "-")
+}
@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// transportCredentials returns the dial option securing the connection to the plugin at host.
+func transportCredentials(cfg plugin.Config, host string) (grpc.DialOption, error) {
+	switch strings.ToLower(cfg.TLS.Mode) {
+	case "", plugin.TLSModeInsecure:
+		return grpc.WithInsecure(), nil
+	case plugin.TLSModeTLS:
+		tlsConfig := &tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		}
+		if cfg.TLS.ServerName != "" {
+			tlsConfig.ServerName = cfg.TLS.ServerName
+		}
+		if cfg.TLS.CACert != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(cfg.TLS.CACert)) {
+				return nil, fmt.Errorf("invalid CA certificate for plugin %s", cfg.Name)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+	case plugin.TLSModeMTLS:
+		if cfg.Authenticator == nil {
+			return nil, fmt.Errorf("plugin %s requires mTLS but mTLS is disabled", cfg.Name)
+		}
+		appID := cfg.TLS.AppID
+		if appID == "" {
+			appID = cfg.Name
+		}
+		serverName := fmt.Sprintf("%s.%s.svc.cluster.local", appID, cfg.Namespace)
+		if cfg.TLS.ServerName != "" {
+			serverName = cfg.TLS.ServerName
+		}
+		auth := cfg.Authenticator
+		tlsConfig := &tls.Config{
+			ServerName: serverName,
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    auth.GetTrustAnchors(),
+			// the workload certificate is rotated by the authenticator, so it is read on every handshake
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				signedCert := auth.GetCurrentSignedCert()
+				if signedCert == nil {
+					return nil, fmt.Errorf("no workload certificate for the connection to plugin %s", cfg.Name)
+				}
+				cert, err := tls.X509KeyPair(signedCert.WorkloadCert, signedCert.PrivateKeyPem)
+				if err != nil {
+					return nil, err
+				}
+				return &cert, nil
+			},
+		}
+		return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+	default:
+		return nil, fmt.Errorf("unknown tls mode %q of plugin %s", cfg.TLS.Mode, cfg.Name)
+	}
+}
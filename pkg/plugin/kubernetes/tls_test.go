@@ -0,0 +1,243 @@
+package kubernetes_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/plugin"
+	"github.com/dapr/dapr/pkg/plugin/kubernetes"
+	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	"github.com/dapr/dapr/pkg/runtime/security"
+	sdk_state "github.com/dapr/dapr/pkg/sdk/state/v1"
+	"github.com/dapr/kit/logger"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// issue returns the PEM encoded certificate and private key of a leaf certificate for the DNS name.
+func (ca *testCA) issue(t *testing.T, dnsName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+type fakeAuthenticator struct {
+	ca   *testCA
+	cert *security.SignedCertificate
+}
+
+func (a *fakeAuthenticator) GetTrustAnchors() *x509.CertPool {
+	return a.ca.pool()
+}
+
+func (a *fakeAuthenticator) GetCurrentSignedCert() *security.SignedCertificate {
+	return a.cert
+}
+
+func (a *fakeAuthenticator) CreateSignedWorkloadCert(id, namespace, trustDomain string) (*security.SignedCertificate, error) {
+	return a.cert, nil
+}
+
+// serveStore serves a memory store on a loopback port and returns the port.
+func serveStore(t *testing.T, opts ...grpc.ServerOption) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	newStoreServer(t, listener, opts...)
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func newStoreServer(t *testing.T, listener net.Listener, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	stateproto.RegisterStoreServer(server, &sdk_state.GRPCServer{Impl: plugin.NewMemoryStore()})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return server
+}
+
+func storeOverNetwork(t *testing.T, cfg plugin.Config, address string, port int) (state.Store, error) {
+	cfg.Version = "v1"
+	discovery := kubernetes.NewAddressDiscovery(net.JoinHostPort(address, strconv.Itoa(port)))
+	p := kubernetes.NewPlugin(logger.NewLogger("test"), cfg, discovery, kubernetes.NewConnectionFactory(cfg, discovery))
+	if err := p.Init(configuration.Metadata{}); err != nil {
+		return nil, err
+	}
+	store, err := p.Store()
+	require.Nil(t, err)
+	return store, store.Init(state.Metadata{})
+}
+
+func TestConnectionFactoryTLS(t *testing.T) {
+	ca := newTestCA(t)
+
+	t.Run("insecure connection to a dns name", func(t *testing.T) {
+		port := serveStore(t)
+		store, err := storeOverNetwork(t, plugin.Config{Name: "plugin"}, "localhost", port)
+		require.Nil(t, err)
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+	})
+
+	serverCert, serverKey := ca.issue(t, "localhost")
+	certificate, err := tls.X509KeyPair(serverCert, serverKey)
+	require.Nil(t, err)
+
+	t.Run("tls verifies the plugin with the configured ca", func(t *testing.T) {
+		port := serveStore(t, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{certificate}})))
+		cfg := plugin.Config{
+			Name: "plugin",
+			TLS:  plugin.TLSConfig{Mode: plugin.TLSModeTLS, CACert: string(ca.pem)},
+		}
+		store, err := storeOverNetwork(t, cfg, "localhost", port)
+		require.Nil(t, err)
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+	})
+
+	t.Run("tls verifies the service name of service plugins", func(t *testing.T) {
+		serviceCert, serviceKey := ca.issue(t, "plugin-v1.default.svc")
+		serviceCertificate, err := tls.X509KeyPair(serviceCert, serviceKey)
+		require.Nil(t, err)
+		port := serveStore(t, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{serviceCertificate}})))
+		cfg := plugin.Config{
+			Name:    "plugin",
+			Version: "v1",
+			TLS:     plugin.TLSConfig{Mode: plugin.TLSModeTLS, CACert: string(ca.pem)},
+		}
+		// the endpoint of the service is a pod address, which the certificate doesn't name
+		discovery := kubernetes.NewServiceDiscovery("default", &fakeSRVResolver{
+			records: map[string][]*net.SRV{
+				"_grpc._tcp.plugin-v1.default.svc": {{Target: "127.0.0.1.", Port: uint16(port)}},
+			},
+		})
+		p := kubernetes.NewPlugin(logger.NewLogger("test"), cfg, discovery, kubernetes.NewConnectionFactory(cfg, discovery))
+		require.Nil(t, p.Init(configuration.Metadata{}))
+		store, err := p.Store()
+		require.Nil(t, err)
+		require.Nil(t, store.Init(state.Metadata{}))
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+	})
+
+	t.Run("tls rejects a plugin signed by another ca", func(t *testing.T) {
+		port := serveStore(t, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{certificate}})))
+		cfg := plugin.Config{
+			Name: "plugin",
+			TLS:  plugin.TLSConfig{Mode: plugin.TLSModeTLS, CACert: string(newTestCA(t).pem)},
+		}
+		_, err := storeOverNetwork(t, cfg, "localhost", port)
+		require.NotNil(t, err)
+	})
+
+	t.Run("tls rejects an invalid ca", func(t *testing.T) {
+		cfg := plugin.Config{
+			Name: "plugin",
+			TLS:  plugin.TLSConfig{Mode: plugin.TLSModeTLS, CACert: "invalid"},
+		}
+		_, err := storeOverNetwork(t, cfg, "localhost", 50001)
+		require.EqualError(t, err, "invalid CA certificate for plugin plugin")
+	})
+
+	t.Run("mtls authenticates with the workload certificate", func(t *testing.T) {
+		pluginCert, pluginKey := ca.issue(t, "plugin-app.default.svc.cluster.local")
+		pluginCertificate, err := tls.X509KeyPair(pluginCert, pluginKey)
+		require.Nil(t, err)
+		port := serveStore(t, grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{pluginCertificate},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    ca.pool(),
+		})))
+		workloadCert, workloadKey := ca.issue(t, "app.default.svc.cluster.local")
+		cfg := plugin.Config{
+			Name:      "plugin",
+			Namespace: "default",
+			TLS:       plugin.TLSConfig{Mode: plugin.TLSModeMTLS, AppID: "plugin-app"},
+			Authenticator: &fakeAuthenticator{
+				ca: ca,
+				cert: &security.SignedCertificate{
+					WorkloadCert:  workloadCert,
+					PrivateKeyPem: workloadKey,
+				},
+			},
+		}
+		store, err := storeOverNetwork(t, cfg, "127.0.0.1", port)
+		require.Nil(t, err)
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+	})
+
+	t.Run("mtls requires mtls to be enabled", func(t *testing.T) {
+		cfg := plugin.Config{
+			Name: "plugin",
+			TLS:  plugin.TLSConfig{Mode: plugin.TLSModeMTLS},
+		}
+		_, err := storeOverNetwork(t, cfg, "localhost", 50001)
+		require.EqualError(t, err, "plugin plugin requires mTLS but mTLS is disabled")
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		cfg := plugin.Config{
+			Name: "plugin",
+			TLS:  plugin.TLSConfig{Mode: "ssl"},
+		}
+		_, err := storeOverNetwork(t, cfg, "localhost", 50001)
+		require.EqualError(t, err, `unknown tls mode "ssl" of plugin plugin`)
+	})
+}
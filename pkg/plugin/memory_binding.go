@@ -0,0 +1,60 @@
+package plugin
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dapr/components-contrib/bindings"
+)
+
+// MemoryBinding is an input and output binding used for testing. Created data is read back by the input binding.
+type MemoryBinding struct {
+	lock    sync.RWMutex
+	handler func(*bindings.ReadResponse) ([]byte, error)
+}
+
+func NewMemoryBinding() *MemoryBinding {
+	return &MemoryBinding{}
+}
+
+func (b *MemoryBinding) Init(metadata bindings.Metadata) error {
+	return nil
+}
+
+// Read registers the handler and returns right away.
+func (b *MemoryBinding) Read(handler func(*bindings.ReadResponse) ([]byte, error)) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.handler = handler
+	return nil
+}
+
+func (b *MemoryBinding) Operations() []bindings.OperationKind {
+	return []bindings.OperationKind{bindings.CreateOperation}
+}
+
+// Invoke delivers created data to the reader of the input binding and returns its response.
+func (b *MemoryBinding) Invoke(req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+	if req.Operation != bindings.CreateOperation {
+		return nil, fmt.Errorf("operation %s is not supported", req.Operation)
+	}
+
+	b.lock.RLock()
+	handler := b.handler
+	b.lock.RUnlock()
+	if handler == nil {
+		return nil, fmt.Errorf("the input binding is not read")
+	}
+
+	data, err := handler(&bindings.ReadResponse{
+		Data:     req.Data,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.InvokeResponse{
+		Data:     data,
+		Metadata: req.Metadata,
+	}, nil
+}
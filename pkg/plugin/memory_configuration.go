@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+// MemoryConfigurationStore is a configuration store used for testing
+type MemoryConfigurationStore struct {
+	lock        sync.RWMutex
+	items       map[string]*configuration.Item
+	subscribers []memoryConfigurationSubscriber
+}
+
+type memoryConfigurationSubscriber struct {
+	ctx     context.Context
+	keys    []string
+	handler configuration.UpdateHandler
+}
+
+func NewMemoryConfigurationStore() *MemoryConfigurationStore {
+	return &MemoryConfigurationStore{
+		items: map[string]*configuration.Item{},
+	}
+}
+
+func (s *MemoryConfigurationStore) Init(metadata configuration.Metadata) error {
+	return nil
+}
+
+func (s *MemoryConfigurationStore) Get(ctx context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	items := []*configuration.Item{}
+	for _, key := range req.Keys {
+		if item, ok := s.items[key]; ok {
+			items = append(items, item)
+		}
+	}
+	return &configuration.GetResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *MemoryConfigurationStore) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.subscribers = append(s.subscribers, memoryConfigurationSubscriber{
+		ctx:     ctx,
+		keys:    req.Keys,
+		handler: handler,
+	})
+	return nil
+}
+
+// Set stores the item and delivers it to the subscribers of its key.
+func (s *MemoryConfigurationStore) Set(item *configuration.Item) {
+	s.lock.Lock()
+	s.items[item.Key] = item
+	subscribers := append([]memoryConfigurationSubscriber(nil), s.subscribers...)
+	s.lock.Unlock()
+
+	for _, subscriber := range subscribers {
+		if subscriber.ctx.Err() != nil {
+			continue
+		}
+		for _, key := range subscriber.keys {
+			if key == item.Key {
+				subscriber.handler(subscriber.ctx, &configuration.UpdateEvent{
+					Items: []*configuration.Item{item},
+				})
+				break
+			}
+		}
+	}
+}
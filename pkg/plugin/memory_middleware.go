@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/valyala/fasthttp"
+)
+
+// DenyHeader is the request header that makes the HeaderMiddleware respond with 403.
+const DenyHeader = "X-Deny"
+
+// HeaderMiddleware is an http middleware used for testing. It sets the header of its metadata on the requests it passes on,
+// and responds to the requests with the DenyHeader itself.
+type HeaderMiddleware struct{}
+
+func NewHeaderMiddleware() *HeaderMiddleware {
+	return &HeaderMiddleware{}
+}
+
+func (m *HeaderMiddleware) GetHandler(metadata middleware.Metadata) (func(h fasthttp.RequestHandler) fasthttp.RequestHandler, error) {
+	header, value := metadata.Properties["header"], metadata.Properties["value"]
+	return func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			if len(ctx.Request.Header.Peek(DenyHeader)) > 0 {
+				ctx.Error("denied", fasthttp.StatusForbidden)
+				return
+			}
+			if header != "" {
+				ctx.Request.Header.Set(header, value)
+			}
+			h(ctx)
+		}
+	}, nil
+}
@@ -0,0 +1,89 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// MemoryPubSub is a pubsub used for testing
+type MemoryPubSub struct {
+	lock          sync.RWMutex
+	handlers      map[string]map[uint64]pubsub.Handler
+	nextHandlerID uint64
+}
+
+func NewMemoryPubSub() *MemoryPubSub {
+	return &MemoryPubSub{
+		handlers: map[string]map[uint64]pubsub.Handler{},
+	}
+}
+
+func (p *MemoryPubSub) Init(metadata pubsub.Metadata) error {
+	return nil
+}
+
+func (p *MemoryPubSub) Features() []pubsub.Feature {
+	return []pubsub.Feature{}
+}
+
+// Publish delivers the message to every subscriber of the topic and returns the first handler error.
+func (p *MemoryPubSub) Publish(req *pubsub.PublishRequest) error {
+	p.lock.RLock()
+	handlers := make([]pubsub.Handler, 0, len(p.handlers[req.Topic]))
+	for _, handler := range p.handlers[req.Topic] {
+		handlers = append(handlers, handler)
+	}
+	p.lock.RUnlock()
+
+	for _, handler := range handlers {
+		err := handler(context.Background(), &pubsub.NewMessage{
+			Data:     req.Data,
+			Topic:    req.Topic,
+			Metadata: req.Metadata,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *MemoryPubSub) Subscribe(req pubsub.SubscribeRequest, handler pubsub.Handler) error {
+	return p.SubscribeWithContext(context.Background(), req, handler)
+}
+
+// SubscribeWithContext subscribes the handler to the topic until the context is done.
+func (p *MemoryPubSub) SubscribeWithContext(ctx context.Context, req pubsub.SubscribeRequest, handler pubsub.Handler) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.handlers[req.Topic] == nil {
+		p.handlers[req.Topic] = map[uint64]pubsub.Handler{}
+	}
+	p.nextHandlerID++
+	id := p.nextHandlerID
+	p.handlers[req.Topic][id] = handler
+
+	if ctx.Done() == nil {
+		return nil
+	}
+	go func() {
+		<-ctx.Done()
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		delete(p.handlers[req.Topic], id)
+	}()
+	return nil
+}
+
+// Subscribers returns the number of subscribers of the topic.
+func (p *MemoryPubSub) Subscribers(topic string) int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.handlers[topic])
+}
+
+func (p *MemoryPubSub) Close() error {
+	return nil
+}
@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/dapr/components-contrib/nameresolution"
+)
+
+// StaticResolver is a name resolver used for testing. It resolves the app IDs to the addresses of its configuration.
+type StaticResolver struct {
+	addresses map[string]interface{}
+}
+
+func NewStaticResolver() *StaticResolver {
+	return &StaticResolver{}
+}
+
+func (r *StaticResolver) Init(metadata nameresolution.Metadata) error {
+	addresses, ok := metadata.Configuration.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected configuration %v", metadata.Configuration)
+	}
+	r.addresses = addresses
+	return nil
+}
+
+func (r *StaticResolver) ResolveID(req nameresolution.ResolveRequest) (string, error) {
+	address, ok := r.addresses[req.ID].(string)
+	if !ok {
+		return "", fmt.Errorf("app id %s not found", req.ID)
+	}
+	return address, nil
+}
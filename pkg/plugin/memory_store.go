@@ -10,7 +10,8 @@ import (
 
 // MemoryStore is a store used for testing
 type MemoryStore struct {
-	data map[string][]byte
+	data     map[string][]byte
+	features []state.Feature
 }
 
 func NewMemoryStore() *MemoryStore {
@@ -19,6 +20,14 @@ func NewMemoryStore() *MemoryStore {
 	}
 }
 
+// NewTransactionalMemoryStore creates a memory store that advertises transaction support
+func NewTransactionalMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		data:     map[string][]byte{},
+		features: []state.Feature{state.FeatureTransactional},
+	}
+}
+
 func (s *MemoryStore) Init(metadata state.Metadata) error {
 
 	for k := range s.data {
@@ -28,8 +37,10 @@ func (s *MemoryStore) Init(metadata state.Metadata) error {
 }
 
 func (s *MemoryStore) Features() []state.Feature {
-
-	return []state.Feature{}
+	if s.features == nil {
+		return []state.Feature{}
+	}
+	return s.features
 }
 
 func (s *MemoryStore) Delete(req *state.DeleteRequest) error {
@@ -99,6 +110,11 @@ func (s *MemoryStore) Ping() error {
 }
 
 func (s *MemoryStore) BulkDelete(req []state.DeleteRequest) error {
+	for i := range req {
+		if err := s.Delete(&req[i]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -115,3 +131,21 @@ func (s *MemoryStore) BulkSet(req []state.SetRequest) error {
 	}
 	return nil
 }
+
+func (s *MemoryStore) Multi(request *state.TransactionalStateRequest) error {
+	for _, o := range request.Operations {
+		switch req := o.Request.(type) {
+		case state.SetRequest:
+			if err := s.Set(&req); err != nil {
+				return err
+			}
+		case state.DeleteRequest:
+			if err := s.Delete(&req); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("multi: unsupported request type %T", o.Request)
+		}
+	}
+	return nil
+}
@@ -3,14 +3,27 @@ package plugin
 import (
 	"fmt"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
+	"google.golang.org/grpc"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 )
 
 // ErrComponentNotImplemented defines a not found error
 var ErrComponentNotImplemented = fmt.Errorf("the plugin component service is not implemented")
 
+// HealthChecker is implemented by plugins that monitor the process serving them.
+type HealthChecker interface {
+	// Health returns an error while the plugin process is down
+	Health() error
+}
+
 type Plugin interface {
 	// Init is called after the plugin is initialized with the metadata loaded from the component CRD
 	Init(metadata configuration.Metadata) error
@@ -18,4 +31,26 @@ type Plugin interface {
 	Store() (state.Store, error)
 	// PubSub returns the pubsub service served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
 	PubSub() (pubsub.PubSub, error)
+	// InputBinding returns the input binding served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	InputBinding() (bindings.InputBinding, error)
+	// OutputBinding returns the output binding served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	OutputBinding() (bindings.OutputBinding, error)
+	// SecretStore returns the secret store served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	SecretStore() (secretstores.SecretStore, error)
+	// ConfigurationStore returns the configuration store served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	ConfigurationStore() (configuration.Store, error)
+	// HTTPMiddleware returns the http middleware served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	HTTPMiddleware() (middleware.Middleware, error)
+	// NameResolver returns the name resolver served by this plugin. If the component is not implemented, ErrComponentNotImplemented is returned
+	NameResolver() (nameresolution.Resolver, error)
+}
+
+// DialOptions returns the options for connections to plugins. Every unary plugin call is traced and measured like the other gRPC clients of the runtime.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(
+			diag.GRPCTraceUnaryClientInterceptor(),
+			diag.DefaultGRPCMonitoring.UnaryClientInterceptor(),
+		),
+	}
 }
@@ -0,0 +1,43 @@
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// listServicesTimeout bounds the lookup of the services served by a plugin.
+const listServicesTimeout = 5 * time.Second
+
+// ListServices returns the grpc services registered by a plugin through the reflection service.
+// go-plugin registers the reflection service on every plugin process.
+func ListServices(conn grpc.ClientConnInterface) (map[string]bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), listServicesTimeout)
+	defer cancel()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{
+			ListServices: "*",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	stream.CloseSend()
+
+	services := map[string]bool{}
+	for _, s := range response.GetListServicesResponse().GetService() {
+		services[s.GetName()] = true
+	}
+	return services, nil
+}
@@ -0,0 +1,118 @@
+package standalone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/dapr/dapr/utils"
+)
+
+const (
+	// ManifestFileName is the name of the manifest next to the plugin file in its version directory.
+	ManifestFileName = "manifest.yaml"
+	// checksumPrefix prefixes the hex encoded sha256 checksum of the plugin file in a manifest.
+	checksumPrefix = "sha256:"
+)
+
+// Manifest describes an installed plugin. It is verified before the plugin is loaded, so an ambiguous or tampered plugin file is rejected.
+type Manifest struct {
+	Name    string  `yaml:"name"`
+	Version string  `yaml:"version"`
+	Runtime Runtime `yaml:"runtime"`
+	// Checksum is the sha256 checksum of the plugin file, e.g. sha256:9f86d0...
+	Checksum string `yaml:"checksum"`
+	// ComponentTypes are the types of the components served by the plugin, e.g. state and pubsub
+	ComponentTypes []string `yaml:"componentTypes"`
+}
+
+// ReadManifest reads and validates the manifest at the path of the filesystem.
+func ReadManifest(filesystem fs.FS, manifestPath string) (*Manifest, error) {
+	b, err := fs.ReadFile(filesystem, manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin manifest %s: %w", manifestPath, err)
+	}
+	var manifest Manifest
+	if err = yaml.UnmarshalStrict(b, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid plugin manifest %s: %w", manifestPath, err)
+	}
+	if err = manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid plugin manifest %s: %w", manifestPath, err)
+	}
+	return &manifest, nil
+}
+
+// Validate checks that every field of the manifest is set and known.
+func (m *Manifest) Validate() error {
+	if m.Name == "" || m.Version == "" {
+		return fmt.Errorf("name and version are required")
+	}
+	if strings.ContainsAny(m.Name+m.Version, `/\`) || m.Name == ".." || m.Version == ".." {
+		return fmt.Errorf("invalid name %q or version %q", m.Name, m.Version)
+	}
+	if _, ok := runtimeContextMap[m.Runtime]; !ok {
+		return fmt.Errorf("unknown runtime %q", m.Runtime)
+	}
+	if !strings.HasPrefix(m.Checksum, checksumPrefix) || len(m.Checksum) != len(checksumPrefix)+sha256.Size*2 {
+		return fmt.Errorf("checksum %q is not a sha256 checksum", m.Checksum)
+	}
+	if len(m.ComponentTypes) == 0 {
+		return fmt.Errorf("no component types")
+	}
+	for _, componentType := range m.ComponentTypes {
+		if _, ok := componentServices[componentType]; !ok {
+			return fmt.Errorf("component type %s cannot be served by a plugin", componentType)
+		}
+	}
+	return nil
+}
+
+// PluginFilePattern returns the pattern matching the file of the plugin in its version directory.
+func (m *Manifest) PluginFilePattern() string {
+	return pluginFilePattern(m.Name, m.Version)
+}
+
+func pluginFilePattern(name, version string) string {
+	return fmt.Sprintf("dapr-%s-%s*", name, version)
+}
+
+// Verify checks that the plugin file matches the manifest: its runtime is the only one matching the file name and its checksum is the manifest checksum.
+func (m *Manifest) Verify(filesystem fs.FS, pluginPath string) error {
+	runtimeContexts := MatchRuntimeContext(pluginPath)
+	if len(runtimeContexts) != 1 || runtimeContexts[0].Name() != m.Runtime {
+		return fmt.Errorf("plugin file %s does not match the runtime %s of the manifest", path.Base(pluginPath), m.Runtime)
+	}
+	f, err := filesystem.Open(pluginPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	checksum, err := Checksum(f)
+	if err != nil {
+		return err
+	}
+	if checksum != strings.ToLower(m.Checksum) {
+		return fmt.Errorf("checksum %s of plugin file %s does not match the manifest checksum %s", checksum, path.Base(pluginPath), m.Checksum)
+	}
+	return nil
+}
+
+// Declares returns true if the manifest declares the component type.
+func (m *Manifest) Declares(componentType string) bool {
+	return utils.StringSliceContains(componentType, m.ComponentTypes)
+}
+
+// Checksum returns the sha256 checksum of the content in the manifest format.
+func Checksum(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return checksumPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,230 @@
+package packages
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/plugin/standalone"
+)
+
+// ErrNotInstalled is returned when removing a plugin that is not installed.
+var ErrNotInstalled = errors.New("plugin is not installed")
+
+// Manager installs, lists and removes the plugins of the plugins directory, which holds each plugin at <name>/<version>/dapr-<name>-<version>* next to its manifest.
+type Manager struct {
+	pluginsPath string
+}
+
+// NewManager returns a manager of the plugins directory.
+func NewManager(pluginsPath string) *Manager {
+	return &Manager{
+		pluginsPath: pluginsPath,
+	}
+}
+
+// Install installs the plugin package at source, a directory or a tarball holding the plugin file and its manifest at its root.
+// The plugin file is verified against the manifest before it is copied, and an installed version is never overwritten.
+func (m *Manager) Install(source string) (*standalone.Manifest, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	packagePath := source
+	if !info.IsDir() {
+		if packagePath, err = os.MkdirTemp("", "dapr-plugin-"); err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(packagePath)
+		if err = extract(source, packagePath); err != nil {
+			return nil, fmt.Errorf("failed to extract plugin package %s: %w", source, err)
+		}
+	}
+
+	packageFS := os.DirFS(packagePath)
+	manifest, err := standalone.ReadManifest(packageFS, standalone.ManifestFileName)
+	if err != nil {
+		return nil, err
+	}
+	pluginFiles, err := fs.Glob(packageFS, manifest.PluginFilePattern())
+	if err != nil {
+		return nil, err
+	}
+	if len(pluginFiles) != 1 {
+		return nil, fmt.Errorf("found (%d) entries that match the path spec %s in plugin package %s. expected one", len(pluginFiles), manifest.PluginFilePattern(), source)
+	}
+	if err = manifest.Verify(packageFS, pluginFiles[0]); err != nil {
+		return nil, fmt.Errorf("plugin package %s is rejected: %w", source, err)
+	}
+
+	versionPath := filepath.Join(m.pluginsPath, manifest.Name, manifest.Version)
+	if _, err = os.Stat(versionPath); err == nil {
+		return nil, fmt.Errorf("plugin %s/%s is already installed", manifest.Name, manifest.Version)
+	}
+	// the package is staged next to its destination, so a failed install leaves no partial version behind
+	pluginPath := filepath.Join(m.pluginsPath, manifest.Name)
+	if err = os.MkdirAll(pluginPath, 0o755); err != nil {
+		return nil, err
+	}
+	stagingPath, err := os.MkdirTemp(pluginPath, "."+manifest.Version+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(stagingPath)
+	if err = copyDir(packagePath, stagingPath); err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(filepath.Join(stagingPath, pluginFiles[0]), 0o755); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(stagingPath, versionPath); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// List returns the manifests of the installed plugins sorted by name and version. Versions without a valid manifest are skipped.
+func (m *Manager) List() ([]*standalone.Manifest, error) {
+	pluginsFS := os.DirFS(m.pluginsPath)
+	manifestPaths, err := fs.Glob(pluginsFS, filepath.ToSlash(filepath.Join("*", "*", standalone.ManifestFileName)))
+	if err != nil {
+		return nil, err
+	}
+	manifests := []*standalone.Manifest{}
+	for _, manifestPath := range manifestPaths {
+		manifest, err := standalone.ReadManifest(pluginsFS, manifestPath)
+		if err != nil {
+			continue
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].Name != manifests[j].Name {
+			return manifests[i].Name < manifests[j].Name
+		}
+		return manifests[i].Version < manifests[j].Version
+	})
+	return manifests, nil
+}
+
+// Remove removes a version of an installed plugin, or every version when the version is empty.
+func (m *Manager) Remove(name, version string) error {
+	if name == "" || strings.ContainsAny(name+version, `/\`) || name == ".." || version == ".." {
+		return fmt.Errorf("invalid plugin name %q or version %q", name, version)
+	}
+	removedPath := filepath.Join(m.pluginsPath, name, version)
+	if _, err := os.Stat(removedPath); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w: %s/%s", ErrNotInstalled, name, version)
+		}
+		return err
+	}
+	if err := os.RemoveAll(removedPath); err != nil {
+		return err
+	}
+	// the plugin directory is removed with its last version
+	if version != "" {
+		if entries, err := os.ReadDir(filepath.Join(m.pluginsPath, name)); err == nil && len(entries) == 0 {
+			return os.Remove(filepath.Join(m.pluginsPath, name))
+		}
+	}
+	return nil
+}
+
+// extract extracts the tarball, compressed with gzip or not, into the directory.
+func extract(tarball, dir string) error {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if gz, err := gzip.NewReader(f); err == nil {
+		defer gz.Close()
+		r = gz
+	} else if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// entries escaping the directory are rejected. the directory itself is the ./ entry written by tar -C dir .
+		target := filepath.Join(dir, header.Name)
+		root := filepath.Clean(dir)
+		if !strings.HasPrefix(target, root+string(os.PathSeparator)) && (target != root || header.Typeflag != tar.TypeDir) {
+			return fmt.Errorf("invalid entry %s", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err = writeFile(target, tr, fs.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported entry %s", header.Name)
+		}
+	}
+}
+
+// copyDir copies the regular files and directories of src into dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("unsupported file %s", rel)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return writeFile(target, f, info.Mode().Perm())
+	})
+}
+
+func writeFile(path string, r io.Reader, perm fs.FileMode) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
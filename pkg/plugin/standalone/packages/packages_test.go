@@ -0,0 +1,187 @@
+package packages_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/plugin/standalone"
+	"github.com/dapr/dapr/pkg/plugin/standalone/packages"
+	"github.com/stretchr/testify/require"
+)
+
+const pluginContent = "#!/bin/sh\n"
+
+func manifest(t *testing.T, name, version, content string) string {
+	checksum, err := standalone.Checksum(strings.NewReader(content))
+	require.Nil(t, err)
+	return "name: " + name + "\nversion: " + version + "\nruntime: exec\nchecksum: " + checksum + "\ncomponentTypes: [state]\n"
+}
+
+// newPackageDir creates a plugin package directory holding the files.
+func newPackageDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+// newPackageTarball creates a gzipped plugin package tarball holding the files.
+// A non empty prefix is written as a directory entry and prefixes the names of the files, like tar -C dir . writes ./
+func newPackageTarball(t *testing.T, prefix string, files map[string]string) string {
+	path := filepath.Join(t.TempDir(), "plugin.tar.gz")
+	f, err := os.Create(path)
+	require.Nil(t, err)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	if prefix != "" {
+		require.Nil(t, tw.WriteHeader(&tar.Header{Name: prefix, Mode: 0o755, Typeflag: tar.TypeDir}))
+	}
+	for name, content := range files {
+		require.Nil(t, tw.WriteHeader(&tar.Header{Name: prefix + name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err = tw.Write([]byte(content))
+		require.Nil(t, err)
+	}
+	require.Nil(t, tw.Close())
+	require.Nil(t, gz.Close())
+	return path
+}
+
+func TestInstall(t *testing.T) {
+	files := map[string]string{
+		"manifest.yaml":    manifest(t, "test", "v1", pluginContent),
+		"dapr-test-v1":     pluginContent,
+		"requirements.txt": "grpcio\n",
+	}
+
+	t.Run("install from a directory", func(t *testing.T) {
+		pluginsPath := t.TempDir()
+		installed, err := packages.NewManager(pluginsPath).Install(newPackageDir(t, files))
+		require.Nil(t, err)
+		require.Equal(t, "test", installed.Name)
+		require.Equal(t, "v1", installed.Version)
+
+		info, err := os.Stat(filepath.Join(pluginsPath, "test", "v1", "dapr-test-v1"))
+		require.Nil(t, err)
+		require.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+		_, err = os.Stat(filepath.Join(pluginsPath, "test", "v1", "requirements.txt"))
+		require.Nil(t, err)
+
+		// the installed plugin is verified like daprd does before loading it
+		pluginsFS := os.DirFS(pluginsPath)
+		loaded, err := standalone.ReadManifest(pluginsFS, "test/v1/manifest.yaml")
+		require.Nil(t, err)
+		require.Nil(t, loaded.Verify(pluginsFS, "test/v1/dapr-test-v1"))
+	})
+
+	t.Run("install from a tarball", func(t *testing.T) {
+		pluginsPath := t.TempDir()
+		_, err := packages.NewManager(pluginsPath).Install(newPackageTarball(t, "", files))
+		require.Nil(t, err)
+		b, err := os.ReadFile(filepath.Join(pluginsPath, "test", "v1", "dapr-test-v1"))
+		require.Nil(t, err)
+		require.Equal(t, pluginContent, string(b))
+	})
+
+	t.Run("install from a tarball of the package directory", func(t *testing.T) {
+		pluginsPath := t.TempDir()
+		_, err := packages.NewManager(pluginsPath).Install(newPackageTarball(t, "./", files))
+		require.Nil(t, err)
+		b, err := os.ReadFile(filepath.Join(pluginsPath, "test", "v1", "dapr-test-v1"))
+		require.Nil(t, err)
+		require.Equal(t, pluginContent, string(b))
+	})
+
+	t.Run("installed version is not overwritten", func(t *testing.T) {
+		manager := packages.NewManager(t.TempDir())
+		_, err := manager.Install(newPackageDir(t, files))
+		require.Nil(t, err)
+		_, err = manager.Install(newPackageDir(t, files))
+		require.EqualError(t, err, "plugin test/v1 is already installed")
+	})
+
+	t.Run("tampered plugin is rejected", func(t *testing.T) {
+		pluginsPath := t.TempDir()
+		_, err := packages.NewManager(pluginsPath).Install(newPackageDir(t, map[string]string{
+			"manifest.yaml": manifest(t, "test", "v1", pluginContent),
+			"dapr-test-v1":  "#!/bin/sh\nrm -rf /\n",
+		}))
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "does not match the manifest checksum")
+		_, err = os.Stat(filepath.Join(pluginsPath, "test", "v1"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("ambiguous plugin files are rejected", func(t *testing.T) {
+		_, err := packages.NewManager(t.TempDir()).Install(newPackageDir(t, map[string]string{
+			"manifest.yaml":   manifest(t, "test", "v1", pluginContent),
+			"dapr-test-v1":    pluginContent,
+			"dapr-test-v1.py": pluginContent,
+		}))
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "found (2) entries")
+	})
+
+	t.Run("package without manifest is rejected", func(t *testing.T) {
+		_, err := packages.NewManager(t.TempDir()).Install(newPackageDir(t, map[string]string{
+			"dapr-test-v1": pluginContent,
+		}))
+		require.NotNil(t, err)
+	})
+
+	t.Run("tarball entries escaping the package are rejected", func(t *testing.T) {
+		_, err := packages.NewManager(t.TempDir()).Install(newPackageTarball(t, "", map[string]string{
+			"../escaped": pluginContent,
+		}))
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "invalid entry ../escaped")
+	})
+}
+
+func TestListAndRemove(t *testing.T) {
+	pluginsPath := t.TempDir()
+	manager := packages.NewManager(pluginsPath)
+	for _, version := range []string{"v2", "v1"} {
+		_, err := manager.Install(newPackageDir(t, map[string]string{
+			"manifest.yaml":        manifest(t, "test", version, pluginContent),
+			"dapr-test-" + version: pluginContent,
+		}))
+		require.Nil(t, err)
+	}
+	_, err := manager.Install(newPackageDir(t, map[string]string{
+		"manifest.yaml": manifest(t, "other", "v1", pluginContent),
+		"dapr-other-v1": pluginContent,
+	}))
+	require.Nil(t, err)
+
+	listed := func() []string {
+		manifests, err := manager.List()
+		require.Nil(t, err)
+		names := []string{}
+		for _, m := range manifests {
+			names = append(names, m.Name+"/"+m.Version)
+		}
+		return names
+	}
+	require.Equal(t, []string{"other/v1", "test/v1", "test/v2"}, listed())
+
+	require.Nil(t, manager.Remove("test", "v1"))
+	require.Equal(t, []string{"other/v1", "test/v2"}, listed())
+
+	require.Nil(t, manager.Remove("test", "v2"))
+	_, err = os.Stat(filepath.Join(pluginsPath, "test"))
+	require.True(t, os.IsNotExist(err))
+
+	require.Nil(t, manager.Remove("other", ""))
+	require.Equal(t, []string{}, listed())
+
+	err = manager.Remove("test", "v1")
+	require.True(t, errors.Is(err, packages.ErrNotInstalled))
+	require.NotNil(t, manager.Remove("..", ""))
+}
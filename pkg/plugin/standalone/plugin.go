@@ -4,24 +4,65 @@ import (
 	"fmt"
 	"io/fs"
 	"path/filepath"
+	"strings"
+	"sync"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/plugin"
+	bindingsproto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	configurationproto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	middlewareproto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+	nameresolutionproto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	pluginproto "github.com/dapr/dapr/pkg/proto/plugin/v1"
+	pubsubproto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	secretstoresproto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
+	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
 	"github.com/dapr/dapr/pkg/sdk"
 	"github.com/dapr/kit/logger"
 	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
 
+	bindings_sdk "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	configuration_sdk "github.com/dapr/dapr/pkg/sdk/configuration/v1"
+	middleware_sdk "github.com/dapr/dapr/pkg/sdk/middleware/v1"
+	nameresolution_sdk "github.com/dapr/dapr/pkg/sdk/nameresolution/v1"
+	plugin_sdk "github.com/dapr/dapr/pkg/sdk/plugin/v1"
+	pubsub_sdk "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
+	secretstores_sdk "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
 	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 )
 
 type Plugin struct {
-	clientProtocol        goplugin.ClientProtocol
 	cfg                   plugin.Config
 	logger                logger.Logger
 	filesystem            fs.FS
 	clientProtocolFactory ClientProtocolFactory
+	supervisor            SupervisorConfig
+
+	pluginPath     string
+	runtimeContext RuntimeContext
+	// manifest is the verified manifest of the plugin file
+	manifest *Manifest
+
+	// lock guards the plugin process, its health and its services
+	lock           sync.RWMutex
+	client         *goplugin.Client
+	clientProtocol goplugin.ClientProtocol
+	health         error
+	// services are the grpc services registered by the plugin process
+	services map[string]bool
+	// conn is shared by the component clients and follows the plugin process across restarts
+	conn    *connection
+	clients []sdk.Reinitializer
+	stop    chan struct{}
+	closed  sync.Once
 }
 
 const BaseDirectoryKey = "standalone.BaseDirectory"
@@ -32,16 +73,33 @@ func DefaultClientProtocolFactory(client *goplugin.Client) (goplugin.ClientProto
 	return client.Client()
 }
 
+// Option configures the standalone plugin.
+type Option func(p *Plugin)
+
+// WithSupervisor sets how the plugin process is monitored and restarted.
+func WithSupervisor(cfg SupervisorConfig) Option {
+	return func(p *Plugin) {
+		p.supervisor = cfg
+	}
+}
+
 func NewPlugin(
 	logger logger.Logger,
 	cfg plugin.Config,
 	filesystem fs.FS,
-	clientProtocolFactory ClientProtocolFactory) plugin.Plugin {
+	clientProtocolFactory ClientProtocolFactory,
+	opts ...Option) plugin.Plugin {
 	p := &Plugin{
 		logger:                logger,
 		cfg:                   cfg,
 		filesystem:            filesystem,
 		clientProtocolFactory: clientProtocolFactory,
+		supervisor:            DefaultSupervisorConfig,
+		conn:                  &connection{},
+		stop:                  make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
 	return p
 }
@@ -56,67 +114,268 @@ func (p *Plugin) Init(m configuration.Metadata) error {
 	if err != nil {
 		return err
 	}
+	p.pluginPath = pluginPath
+	p.runtimeContext = runtimeContext
 
-	// enumerate the files in the plugin directory
-	cmd := runtimeContext.Command(pluginPath)
+	if err = p.start(); err != nil {
+		return err
+	}
+	if err = p.initProcess(m); err != nil {
+		p.Close()
+		return err
+	}
+	p.setHealth(nil)
+
+	if p.supervisor.Interval > 0 {
+		go p.supervise()
+	}
+	return nil
+}
 
-	// additional maps would be joined to this
-	pluginMap := goplugin.PluginSet{}
-	for k, v := range state_sdk.PluginMap {
-		pluginMap[k] = v
+// initProcess passes the plugin metadata to the plugin process when it serves the plugin service.
+// The client is the first one replayed after a restart, so the process is configured before its components.
+func (p *Plugin) initProcess(m configuration.Metadata) error {
+	if !p.serves(pluginproto.Plugin_ServiceDesc.ServiceName) {
+		return nil
+	}
+	client := plugin_sdk.NewGRPCClient(pluginproto.NewPluginClient(p.conn))
+	client.SetTimeout(p.cfg.Timeout)
+	if err := client.Init(m); err != nil {
+		return fmt.Errorf("plugin %s/%s failed to initialize: %w", p.cfg.Name, p.cfg.Version, err)
+	}
+	p.addClient(client)
+	return nil
+}
+
+// start launches a new plugin process and moves the component clients onto it. A running process is stopped.
+func (p *Plugin) start() error {
+	// the plugin file is verified on every launch, so a file replaced after the plugin was loaded is not restarted
+	if err := p.verifyManifest(); err != nil {
+		return err
 	}
 
-	p.logger.Debugf("loading runtime '%s' plugin %s", runtimeContext.Name(), cmd)
+	// enumerate the files in the plugin directory
+	cmd := p.runtimeContext.Command(p.commandPath())
+
+	p.logger.Debugf("loading runtime '%s' plugin %s", p.runtimeContext.Name(), cmd)
 	client := goplugin.NewClient(&goplugin.ClientConfig{
-		HandshakeConfig: sdk.Handshake,
-		Plugins:         pluginMap,
-		Cmd:             cmd,
+		HandshakeConfig:  sdk.Handshake,
+		VersionedPlugins: versionedPlugins(),
+		Cmd:              cmd,
 		AllowedProtocols: []goplugin.Protocol{
 			goplugin.ProtocolGRPC,
 		},
+		GRPCDialOptions: plugin.DialOptions(),
 	})
 
 	clientProtocol, err := p.clientProtocolFactory(client)
 	if err != nil {
+		client.Kill()
+		return fmt.Errorf("plugin %s/%s could not be loaded: %w", p.cfg.Name, p.cfg.Version, err)
+	}
+	conn, err := clientConn(clientProtocol)
+	if err == nil {
+		err = p.verify(conn, client.NegotiatedVersion())
+	}
+	if err != nil {
+		clientProtocol.Close()
+		client.Kill()
 		return err
 	}
-	p.clientProtocol = clientProtocol
+
+	p.lock.Lock()
+	// Close may have run while the process was launching, so the new process is not installed
+	select {
+	case <-p.stop:
+		p.lock.Unlock()
+		clientProtocol.Close()
+		client.Kill()
+		return errPluginClosed
+	default:
+	}
+	previousClient, previousProtocol := p.client, p.clientProtocol
+	p.client, p.clientProtocol = client, clientProtocol
+	p.conn.set(conn)
+	p.lock.Unlock()
+
+	if previousProtocol != nil {
+		previousProtocol.Close()
+		previousClient.Kill()
+	}
 	return nil
 }
 
-func (c *Plugin) Store() (state.Store, error) {
-	name := state_sdk.ProtocolGRPC
-	value, err := c.clientProtocol.Dispense(name)
+// verify checks that the plugin process serves the component types of the plugin and records the served services.
+func (p *Plugin) verify(conn grpc.ClientConnInterface, protocolVersion int) error {
+	services, err := plugin.ListServices(conn)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("plugin %s/%s: error listing the served components: %w", p.cfg.Name, p.cfg.Version, err)
 	}
-	store, ok := value.(state.Store)
-	if !ok {
-		return nil, fmt.Errorf("expected %s to be state.Store", name)
+	if err = p.verifyComponentTypes(services, protocolVersion); err != nil {
+		return err
 	}
-	return store, nil
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.services = services
+	return nil
+}
+
+// serves returns true if the plugin process serves the grpc service.
+func (p *Plugin) serves(service string) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.services[service]
 }
 
-func (c *Plugin) PubSub() (pubsub.PubSub, error) {
-	name := string(sdk.ProtocolGRPC)
-	value, err := c.clientProtocol.Dispense(name)
+// clientConn returns the grpc connection of the plugin process
+func clientConn(clientProtocol goplugin.ClientProtocol) (grpc.ClientConnInterface, error) {
+	switch c := clientProtocol.(type) {
+	case *goplugin.GRPCClient:
+		return c.Conn, nil
+	case grpc.ClientConnInterface:
+		return c, nil
+	}
+	return nil, fmt.Errorf("plugin protocol %T does not support grpc", clientProtocol)
+}
+
+func (p *Plugin) Store() (state.Store, error) {
+	if !p.serves(stateproto.Store_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	client := state_sdk.NewGRPCClient(stateproto.NewStoreClient(p.conn))
+	client.SetTimeout(p.cfg.Timeout)
+	store, err := state_sdk.NewStore(client)
 	if err != nil {
 		return nil, err
 	}
-	store, ok := value.(pubsub.PubSub)
-	if !ok {
-		return nil, fmt.Errorf("expected %s to be pubsub.PubSub", name)
+	p.addClient(client)
+	return store, nil
+}
+
+func (p *Plugin) PubSub() (pubsub.PubSub, error) {
+	if !p.serves(pubsubproto.PubSub_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	pubSub := pubsub_sdk.NewGRPCClient(pubsubproto.NewPubSubClient(p.conn))
+	pubSub.SetTimeout(p.cfg.Timeout)
+	p.addClient(pubSub)
+	return pubSub, nil
+}
+
+func (p *Plugin) InputBinding() (bindings.InputBinding, error) {
+	if !p.serves(bindingsproto.InputBinding_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	binding := bindings_sdk.NewGRPCInputClient(bindingsproto.NewInputBindingClient(p.conn))
+	binding.SetTimeout(p.cfg.Timeout)
+	p.addClient(binding)
+	return binding, nil
+}
+
+func (p *Plugin) OutputBinding() (bindings.OutputBinding, error) {
+	if !p.serves(bindingsproto.OutputBinding_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	binding := bindings_sdk.NewGRPCOutputClient(bindingsproto.NewOutputBindingClient(p.conn))
+	binding.SetTimeout(p.cfg.Timeout)
+	p.addClient(binding)
+	return binding, nil
+}
+
+func (p *Plugin) SecretStore() (secretstores.SecretStore, error) {
+	if !p.serves(secretstoresproto.SecretStore_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
 	}
+	store := secretstores_sdk.NewGRPCClient(secretstoresproto.NewSecretStoreClient(p.conn))
+	store.SetTimeout(p.cfg.Timeout)
+	p.addClient(store)
 	return store, nil
 }
 
-func (c *Plugin) Close() error {
-	return c.clientProtocol.Close()
+func (p *Plugin) ConfigurationStore() (configuration.Store, error) {
+	if !p.serves(configurationproto.ConfigurationStore_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	store := configuration_sdk.NewGRPCClient(configurationproto.NewConfigurationStoreClient(p.conn))
+	store.SetTimeout(p.cfg.Timeout)
+	p.addClient(store)
+	return store, nil
+}
+
+func (p *Plugin) HTTPMiddleware() (middleware.Middleware, error) {
+	if !p.serves(middlewareproto.HTTPMiddleware_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	m := middleware_sdk.NewGRPCClient(middlewareproto.NewHTTPMiddlewareClient(p.conn))
+	m.SetTimeout(p.cfg.Timeout)
+	p.addClient(m)
+	return m, nil
+}
+
+func (p *Plugin) NameResolver() (nameresolution.Resolver, error) {
+	if !p.serves(nameresolutionproto.Resolver_ServiceDesc.ServiceName) {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	resolver := nameresolution_sdk.NewGRPCClient(nameresolutionproto.NewResolverClient(p.conn))
+	resolver.SetTimeout(p.cfg.Timeout)
+	p.addClient(resolver)
+	return resolver, nil
+}
+
+func (p *Plugin) addClient(client sdk.Reinitializer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.clients = append(p.clients, client)
 }
 
+// Health returns the reason the plugin process is down, or nil while it is up.
+func (p *Plugin) Health() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.health
+}
+
+func (p *Plugin) setHealth(err error) {
+	p.lock.Lock()
+	p.health = err
+	p.lock.Unlock()
+	diag.DefaultMonitoring.PluginStatusReported(p.cfg.Name, err == nil)
+}
+
+func (p *Plugin) Close() error {
+	p.closed.Do(func() {
+		close(p.stop)
+	})
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.clientProtocol == nil {
+		return nil
+	}
+	err := p.clientProtocol.Close()
+	p.client.Kill()
+	return err
+}
+
+// FilesystemRoot returns the directory the filesystem of the plugins is rooted at when the plugins path is absolute, e.g. / or C:\ on windows.
+func FilesystemRoot(pluginsPath string) string {
+	return filepath.VolumeName(pluginsPath) + string(filepath.Separator)
+}
+
+// createPluginWildcardPath returns the pattern of the plugin file in the filesystem. An absolute plugins path is relative to the root of the filesystem, see FilesystemRoot.
 func (p *Plugin) createPluginWildcardPath() string {
-	fileName := fmt.Sprintf("dapr-%s-%s*", p.cfg.Name, p.cfg.Version)
-	return filepath.Join(p.cfg.Standalone.PluginsPath, p.cfg.Name, p.cfg.Version, fileName)
+	wildcardPath := filepath.Join(p.cfg.Standalone.PluginsPath, p.cfg.Name, p.cfg.Version, pluginFilePattern(p.cfg.Name, p.cfg.Version))
+	wildcardPath = strings.TrimPrefix(wildcardPath, filepath.VolumeName(wildcardPath))
+	return strings.TrimPrefix(filepath.ToSlash(wildcardPath), "/")
+}
+
+// commandPath returns the path the plugin file is executed from.
+func (p *Plugin) commandPath() string {
+	if filepath.IsAbs(p.cfg.Standalone.PluginsPath) {
+		return filepath.Join(FilesystemRoot(p.cfg.Standalone.PluginsPath), filepath.FromSlash(p.pluginPath))
+	}
+	return filepath.FromSlash(p.pluginPath)
 }
 
 func (p *Plugin) getPluginPath() (string, error) {
@@ -135,6 +394,22 @@ func (p *Plugin) getPluginPath() (string, error) {
 	return entries[0], nil
 }
 
+// verifyManifest checks the plugin file against the manifest of its version directory.
+func (p *Plugin) verifyManifest() error {
+	manifest, err := ReadManifest(p.filesystem, filepath.Join(filepath.Dir(p.pluginPath), ManifestFileName))
+	if err != nil {
+		return fmt.Errorf("plugin %s/%s: %w", p.cfg.Name, p.cfg.Version, err)
+	}
+	if manifest.Name != p.cfg.Name || manifest.Version != p.cfg.Version {
+		return fmt.Errorf("plugin %s/%s: the manifest describes plugin %s/%s", p.cfg.Name, p.cfg.Version, manifest.Name, manifest.Version)
+	}
+	if err = manifest.Verify(p.filesystem, p.pluginPath); err != nil {
+		return fmt.Errorf("plugin %s/%s is rejected: %w", p.cfg.Name, p.cfg.Version, err)
+	}
+	p.manifest = manifest
+	return nil
+}
+
 func (p *Plugin) matchRuntimeContext(pluginPath string) (RuntimeContext, error) {
 	runtimeContexts := MatchRuntimeContext(pluginPath)
 	if len(runtimeContexts) != 1 {
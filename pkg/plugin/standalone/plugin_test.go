@@ -1,48 +1,149 @@
 package standalone_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
+	"net"
+	"sync"
 	"testing"
 	"testing/fstest"
+	"time"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/state"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	"github.com/dapr/dapr/pkg/plugin"
 	"github.com/dapr/dapr/pkg/plugin/standalone"
+	bindingsproto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	pubsubproto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	bindings_sdk "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	pubsub_sdk "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
 	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
 	"github.com/dapr/kit/logger"
 	goplugin "github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
+// mockClientProtocol serves the memory components over an in-process connection, in place of a plugin process
 type mockClientProtocol struct {
+	*grpc.ClientConn
+	server *grpc.Server
+	lock   sync.Mutex
+	down   bool
+	hung   chan struct{}
 }
 
-func (p *mockClientProtocol) Dispense(name string) (interface{}, error) {
-	switch name {
-	case state_sdk.ProtocolGRPC:
-		return plugin.NewMemoryStore(), nil
+func newMockClientProtocol(store state.Store) *mockClientProtocol {
+	return newMockClientProtocolWithComponents(store, plugin.NewMemoryPubSub(), nil)
+}
+
+// newMockClientProtocolWithComponents serves the given components, nil components are not served
+func newMockClientProtocolWithComponents(store state.Store, pubSub pubsub.PubSub, output bindings.OutputBinding) *mockClientProtocol {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	if store != nil {
+		stateproto.RegisterStoreServer(server, &state_sdk.GRPCServer{Impl: store})
+	}
+	if pubSub != nil {
+		pubsubproto.RegisterPubSubServer(server, &pubsub_sdk.GRPCServer{Impl: pubSub})
+	}
+	if output != nil {
+		bindingsproto.RegisterOutputBindingServer(server, &bindings_sdk.GRPCOutputServer{Impl: output})
 	}
+	// go-plugin registers the reflection service on every plugin
+	reflection.Register(server)
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &mockClientProtocol{
+		ClientConn: conn,
+		server:     server,
+	}
+}
+
+func (p *mockClientProtocol) Dispense(name string) (interface{}, error) {
 	return nil, fmt.Errorf("unrecognized service %s", name)
 }
 
 func (p *mockClientProtocol) Ping() error {
+	p.lock.Lock()
+	hung := p.hung
+	if p.down {
+		p.lock.Unlock()
+		return errors.New("plugin is down")
+	}
+	p.lock.Unlock()
+
+	if hung != nil {
+		<-hung
+	}
 	return nil
 }
 
+// hang makes the pings of the plugin block until the returned function is called
+func (p *mockClientProtocol) hang() func() {
+	hung := make(chan struct{})
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.hung = hung
+	return func() { close(hung) }
+}
+
+// crash makes the plugin fail its pings
+func (p *mockClientProtocol) crash() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.down = true
+	p.server.Stop()
+}
+
 func (p *mockClientProtocol) Close() error {
-	return nil
+	p.server.Stop()
+	return p.ClientConn.Close()
 }
 
 func MockClientProtocolFactory(client *goplugin.Client) (goplugin.ClientProtocol, error) {
-	return &mockClientProtocol{}, nil
+	return newMockClientProtocol(plugin.NewMemoryStore()), nil
 }
 
-func TestStorePlugin(t *testing.T) {
-	var mapFS = fstest.MapFS{
+// emptyChecksum is the checksum of the empty plugin files of the tests
+const emptyChecksum = "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// pluginFS returns a filesystem holding the empty plugin file test/v1 and its manifest
+func pluginFS() fstest.MapFS {
+	return fstest.MapFS{
 		"root/plugins/test/v1/dapr-test-v1": {},
+		"root/plugins/test/v1/manifest.yaml": {
+			Data: []byte(`name: test
+version: v1
+runtime: exec
+checksum: ` + emptyChecksum + `
+componentTypes: [state, pubsub, bindings]
+`),
+		},
 	}
+}
+
+func TestStorePlugin(t *testing.T) {
+	var mapFS = pluginFS()
 	m := configuration.Metadata{
 		Properties: map[string]string{},
 	}
@@ -98,3 +199,343 @@ func TestStorePlugin(t *testing.T) {
 		require.Equal(t, TestValue, string(response.Data))
 	})
 }
+
+func TestPubSubPlugin(t *testing.T) {
+	var mapFS = pluginFS()
+	p := standalone.NewPlugin(
+		logger.NewLogger("default"),
+		plugin.Config{
+			Name:    "test",
+			Version: "v1",
+			Type:    "pubsub",
+			Standalone: config.StandaloneConfig{
+				PluginsPath: "root/plugins",
+			},
+		},
+		mapFS,
+		MockClientProtocolFactory)
+
+	err := p.Init(configuration.Metadata{})
+	require.Nil(t, err)
+
+	pubSub, err := p.PubSub()
+	require.Nil(t, err)
+	require.NotNil(t, pubSub)
+	require.IsType(t, &pubsub_sdk.GRPCClient{}, pubSub)
+	require.Nil(t, pubSub.Init(pubsub.Metadata{}))
+}
+
+// initRecordingStore records the metadata of every Init
+type initRecordingStore struct {
+	*plugin.MemoryStore
+	inits chan state.Metadata
+}
+
+func (s *initRecordingStore) Init(metadata state.Metadata) error {
+	s.inits <- metadata
+	return s.MemoryStore.Init(metadata)
+}
+
+func TestPluginSupervisor(t *testing.T) {
+	var mapFS = pluginFS()
+	inits := make(chan state.Metadata, 10)
+
+	var lock sync.Mutex
+	var protocols []*mockClientProtocol
+	failedRestarts := 0
+	factory := func(client *goplugin.Client) (goplugin.ClientProtocol, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		// the first restart attempts fail
+		if len(protocols) == 1 && failedRestarts < 2 {
+			failedRestarts++
+			return nil, errors.New("plugin failed to start")
+		}
+		protocol := newMockClientProtocol(&initRecordingStore{
+			MemoryStore: plugin.NewMemoryStore(),
+			inits:       inits,
+		})
+		protocols = append(protocols, protocol)
+		return protocol, nil
+	}
+
+	p := standalone.NewPlugin(
+		logger.NewLogger("default"),
+		plugin.Config{
+			Name:    "test",
+			Version: "v1",
+			Type:    "state",
+			Standalone: config.StandaloneConfig{
+				PluginsPath: "root/plugins",
+			},
+		},
+		mapFS,
+		factory,
+		standalone.WithSupervisor(standalone.SupervisorConfig{
+			Interval:       10 * time.Millisecond,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+		}))
+	defer p.(*standalone.Plugin).Close()
+
+	require.Nil(t, p.Init(configuration.Metadata{}))
+	health := p.(plugin.HealthChecker)
+	require.Nil(t, health.Health())
+
+	store, err := p.Store()
+	require.Nil(t, err)
+	metadata := state.Metadata{Properties: map[string]string{"key": "value"}}
+	require.Nil(t, store.Init(metadata))
+	require.Equal(t, metadata, <-inits)
+
+	lock.Lock()
+	protocols[0].crash()
+	lock.Unlock()
+
+	require.Eventually(t, func() bool {
+		return health.Health() != nil
+	}, time.Second, 5*time.Millisecond)
+
+	t.Run("init is replayed on the restarted plugin", func(t *testing.T) {
+		select {
+		case m := <-inits:
+			require.Equal(t, metadata, m)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "plugin was not initialized again")
+		}
+		require.Eventually(t, func() bool {
+			return health.Health() == nil
+		}, time.Second, 5*time.Millisecond)
+
+		lock.Lock()
+		require.Len(t, protocols, 2)
+		require.Equal(t, 2, failedRestarts)
+		lock.Unlock()
+	})
+
+	t.Run("components use the restarted plugin", func(t *testing.T) {
+		require.Nil(t, store.Set(&state.SetRequest{Key: "key", Value: "value"}))
+		response, err := store.Get(&state.GetRequest{Key: "key"})
+		require.Nil(t, err)
+		require.Equal(t, "value", string(response.Data))
+	})
+
+	t.Run("hung plugin is restarted", func(t *testing.T) {
+		lock.Lock()
+		release := protocols[1].hang()
+		lock.Unlock()
+		defer release()
+
+		require.Eventually(t, func() bool {
+			return health.Health() != nil
+		}, time.Second, 5*time.Millisecond)
+		require.Eventually(t, func() bool {
+			lock.Lock()
+			defer lock.Unlock()
+			return len(protocols) == 3 && health.Health() == nil
+		}, 5*time.Second, 5*time.Millisecond)
+	})
+}
+
+func TestPluginClosedWhileRestarting(t *testing.T) {
+	var mapFS = pluginFS()
+	restarting := make(chan struct{})
+	release := make(chan struct{})
+
+	var lock sync.Mutex
+	var protocols []*mockClientProtocol
+	factory := func(client *goplugin.Client) (goplugin.ClientProtocol, error) {
+		lock.Lock()
+		restart := len(protocols) > 0
+		lock.Unlock()
+		// the restarted process finishes launching only after the plugin is closed
+		if restart {
+			close(restarting)
+			<-release
+		}
+		protocol := newMockClientProtocol(plugin.NewMemoryStore())
+		lock.Lock()
+		protocols = append(protocols, protocol)
+		lock.Unlock()
+		return protocol, nil
+	}
+
+	p := standalone.NewPlugin(
+		logger.NewLogger("default"),
+		plugin.Config{
+			Name:    "test",
+			Version: "v1",
+			Type:    "state",
+			Standalone: config.StandaloneConfig{
+				PluginsPath: "root/plugins",
+			},
+		},
+		mapFS,
+		factory,
+		standalone.WithSupervisor(standalone.SupervisorConfig{
+			Interval:       10 * time.Millisecond,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+		}))
+	require.Nil(t, p.Init(configuration.Metadata{}))
+
+	lock.Lock()
+	protocols[0].crash()
+	lock.Unlock()
+
+	select {
+	case <-restarting:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "plugin was not restarted")
+	}
+	require.Nil(t, p.(*standalone.Plugin).Close())
+	close(release)
+
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(protocols) == 2 && protocols[1].GetState() == connectivity.Shutdown
+	}, 5*time.Second, 5*time.Millisecond)
+}
+
+func TestPluginComponentTypes(t *testing.T) {
+	var mapFS = pluginFS()
+	newPlugin := func(componentTypes []string, protocol *mockClientProtocol) plugin.Plugin {
+		return standalone.NewPlugin(
+			logger.NewLogger("default"),
+			plugin.Config{
+				Name:           "test",
+				Version:        "v1",
+				ComponentTypes: componentTypes,
+				Standalone: config.StandaloneConfig{
+					PluginsPath: "root/plugins",
+				},
+			},
+			mapFS,
+			func(client *goplugin.Client) (goplugin.ClientProtocol, error) {
+				return protocol, nil
+			})
+	}
+
+	t.Run("one plugin serves state and pubsub", func(t *testing.T) {
+		p := newPlugin([]string{standalone.ComponentTypeState, standalone.ComponentTypePubSub}, newMockClientProtocol(plugin.NewMemoryStore()))
+		require.Nil(t, p.Init(configuration.Metadata{}))
+		store, err := p.Store()
+		require.Nil(t, err)
+		require.Nil(t, store.Init(state.Metadata{}))
+		pubSub, err := p.PubSub()
+		require.Nil(t, err)
+		require.Nil(t, pubSub.Init(pubsub.Metadata{}))
+	})
+	t.Run("plugin without the component type is rejected", func(t *testing.T) {
+		p := newPlugin([]string{standalone.ComponentTypePubSub}, newMockClientProtocolWithComponents(plugin.NewMemoryStore(), nil, nil))
+		err := p.Init(configuration.Metadata{})
+		require.EqualError(t, err, "plugin test/v1 is incompatible: it does not serve pubsub components (protocol version 0, serves: state)")
+	})
+	t.Run("unknown component type is rejected", func(t *testing.T) {
+		p := newPlugin([]string{"unknown"}, newMockClientProtocol(plugin.NewMemoryStore()))
+		err := p.Init(configuration.Metadata{})
+		require.EqualError(t, err, "plugin test/v1: component type unknown cannot be served by a plugin")
+	})
+	t.Run("component that is not served is not implemented", func(t *testing.T) {
+		p := newPlugin(nil, newMockClientProtocolWithComponents(nil, plugin.NewMemoryPubSub(), nil))
+		require.Nil(t, p.Init(configuration.Metadata{}))
+		_, err := p.Store()
+		require.Equal(t, plugin.ErrComponentNotImplemented, err)
+		_, err = p.PubSub()
+		require.Nil(t, err)
+	})
+	t.Run("output binding plugin serves bindings", func(t *testing.T) {
+		p := newPlugin([]string{standalone.ComponentTypeBindings}, newMockClientProtocolWithComponents(nil, nil, plugin.NewMemoryBinding()))
+		require.Nil(t, p.Init(configuration.Metadata{}))
+		output, err := p.OutputBinding()
+		require.Nil(t, err)
+		require.Nil(t, output.Init(bindings.Metadata{}))
+		require.Equal(t, []bindings.OperationKind{bindings.CreateOperation}, output.Operations())
+		_, err = p.InputBinding()
+		require.Equal(t, plugin.ErrComponentNotImplemented, err)
+	})
+}
+
+func TestPluginManifest(t *testing.T) {
+	newPlugin := func(filesystem fstest.MapFS, componentTypes []string) plugin.Plugin {
+		return standalone.NewPlugin(
+			logger.NewLogger("default"),
+			plugin.Config{
+				Name:           "test",
+				Version:        "v1",
+				ComponentTypes: componentTypes,
+				Standalone: config.StandaloneConfig{
+					PluginsPath: "root/plugins",
+				},
+			},
+			filesystem,
+			MockClientProtocolFactory)
+	}
+	withManifest := func(manifest string) fstest.MapFS {
+		filesystem := pluginFS()
+		filesystem["root/plugins/test/v1/manifest.yaml"] = &fstest.MapFile{Data: []byte(manifest)}
+		return filesystem
+	}
+
+	t.Run("verified plugin is loaded", func(t *testing.T) {
+		require.Nil(t, newPlugin(pluginFS(), []string{standalone.ComponentTypeState}).Init(configuration.Metadata{}))
+	})
+	t.Run("plugin without manifest is rejected", func(t *testing.T) {
+		filesystem := pluginFS()
+		delete(filesystem, "root/plugins/test/v1/manifest.yaml")
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "plugin test/v1: failed to read plugin manifest")
+	})
+	t.Run("tampered plugin file is rejected", func(t *testing.T) {
+		filesystem := pluginFS()
+		filesystem["root/plugins/test/v1/dapr-test-v1"] = &fstest.MapFile{Data: []byte("tampered")}
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "plugin test/v1 is rejected: checksum")
+	})
+	t.Run("manifest of another plugin is rejected", func(t *testing.T) {
+		filesystem := withManifest("name: other\nversion: v1\nruntime: exec\nchecksum: " + emptyChecksum + "\ncomponentTypes: [state]\n")
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.EqualError(t, err, "plugin test/v1: the manifest describes plugin other/v1")
+	})
+	t.Run("manifest with another runtime is rejected", func(t *testing.T) {
+		filesystem := withManifest("name: test\nversion: v1\nruntime: python\nchecksum: " + emptyChecksum + "\ncomponentTypes: [state]\n")
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.EqualError(t, err, "plugin test/v1 is rejected: plugin file dapr-test-v1 does not match the runtime python of the manifest")
+	})
+	t.Run("component type missing from the manifest is rejected", func(t *testing.T) {
+		filesystem := withManifest("name: test\nversion: v1\nruntime: exec\nchecksum: " + emptyChecksum + "\ncomponentTypes: [pubsub]\n")
+		err := newPlugin(filesystem, []string{standalone.ComponentTypeState}).Init(configuration.Metadata{})
+		require.EqualError(t, err, "plugin test/v1: the manifest does not declare state components (declares: pubsub)")
+	})
+	t.Run("invalid manifest is rejected", func(t *testing.T) {
+		filesystem := withManifest("name: test\nversion: v1\nruntime: exec\nchecksum: md5:abc\ncomponentTypes: [state]\n")
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), `checksum "md5:abc" is not a sha256 checksum`)
+	})
+	t.Run("ambiguous plugin files are rejected", func(t *testing.T) {
+		filesystem := pluginFS()
+		filesystem["root/plugins/test/v1/dapr-test-v1.py"] = &fstest.MapFile{}
+		err := newPlugin(filesystem, nil).Init(configuration.Metadata{})
+		require.NotNil(t, err)
+	})
+}
+
+func TestAbsolutePluginsPath(t *testing.T) {
+	// the filesystem of daprd is rooted at /, so an absolute plugins path is looked up from its root
+	p := standalone.NewPlugin(
+		logger.NewLogger("default"),
+		plugin.Config{
+			Name:    "test",
+			Version: "v1",
+			Standalone: config.StandaloneConfig{
+				PluginsPath: "/root/plugins",
+			},
+		},
+		pluginFS(),
+		MockClientProtocolFactory)
+	require.Nil(t, p.Init(configuration.Metadata{}))
+}
@@ -0,0 +1,166 @@
+package standalone
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// SupervisorConfig configures how the plugin process is monitored and restarted.
+type SupervisorConfig struct {
+	// Interval between two pings of the plugin process, which also bounds each ping. Zero disables the supervision
+	Interval time.Duration
+	// InitialBackoff and MaxBackoff bound the exponential backoff between restart attempts
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultSupervisorConfig is the supervision used unless WithSupervisor is given.
+var DefaultSupervisorConfig = SupervisorConfig{
+	Interval:       5 * time.Second,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+}
+
+var (
+	errPluginExited = errors.New("the plugin process exited")
+	errPingTimeout  = errors.New("the plugin process didn't answer the ping in time")
+	errPluginClosed = errors.New("the plugin is closed")
+)
+
+// supervise pings the plugin process on every interval and restarts it when it is down.
+func (p *Plugin) supervise() {
+	ticker := time.NewTicker(p.supervisor.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+
+		if err := p.ping(); err != nil {
+			p.logger.Warnf("plugin %s/%s is down: %s", p.cfg.Name, p.cfg.Version, err)
+			p.setHealth(err)
+			p.restart()
+		}
+	}
+}
+
+// ping checks the health of the plugin process. The health check is bounded by the supervision interval and doesn't hold the plugin lock, so a hung process doesn't block its restart.
+func (p *Plugin) ping() error {
+	p.lock.RLock()
+	client, clientProtocol := p.client, p.clientProtocol
+	p.lock.RUnlock()
+
+	if client.Exited() {
+		return errPluginExited
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.supervisor.Interval)
+	defer cancel()
+
+	// the ping of go-plugin has no deadline, so the health service is called directly
+	if c, ok := clientProtocol.(*goplugin.GRPCClient); ok {
+		_, err := grpc_health_v1.NewHealthClient(c.Conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+			Service: goplugin.GRPCServiceName,
+		})
+		return err
+	}
+
+	pinged := make(chan error, 1)
+	go func() {
+		pinged <- clientProtocol.Ping()
+	}()
+	select {
+	case err := <-pinged:
+		return err
+	case <-ctx.Done():
+		return errPingTimeout
+	}
+}
+
+// restart starts a new plugin process with an exponential backoff until the process is up and the components are initialized again.
+func (p *Plugin) restart() {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = p.supervisor.InitialBackoff
+	b.MaxInterval = p.supervisor.MaxBackoff
+	b.MaxElapsedTime = 0
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-time.After(b.NextBackOff()):
+		}
+
+		if err := p.start(); err != nil {
+			if errors.Is(err, errPluginClosed) {
+				return
+			}
+			p.logger.Warnf("error restarting plugin %s/%s: %s", p.cfg.Name, p.cfg.Version, err)
+			diag.DefaultMonitoring.PluginRestartFailed(p.cfg.Name, "start")
+			continue
+		}
+		if err := p.reinit(); err != nil {
+			p.logger.Warnf("error initializing restarted plugin %s/%s: %s", p.cfg.Name, p.cfg.Version, err)
+			diag.DefaultMonitoring.PluginRestartFailed(p.cfg.Name, "init")
+			continue
+		}
+
+		p.logger.Infof("plugin %s/%s restarted", p.cfg.Name, p.cfg.Version)
+		diag.DefaultMonitoring.PluginRestarted(p.cfg.Name)
+		p.setHealth(nil)
+		return
+	}
+}
+
+// reinit replays the initialization of the component clients on the current plugin process.
+func (p *Plugin) reinit() error {
+	p.lock.RLock()
+	clients := append([]sdk.Reinitializer(nil), p.clients...)
+	p.lock.RUnlock()
+
+	for _, c := range clients {
+		if err := c.Reinit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connection routes the calls of the component clients to the connection of the current plugin process.
+type connection struct {
+	lock sync.RWMutex
+	conn grpc.ClientConnInterface
+}
+
+func (c *connection) set(conn grpc.ClientConnInterface) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.conn = conn
+}
+
+func (c *connection) get() grpc.ClientConnInterface {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.conn
+}
+
+func (c *connection) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	return c.get().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c *connection) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.get().NewStream(ctx, desc, method, opts...)
+}
@@ -0,0 +1,110 @@
+package standalone
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	goplugin "github.com/hashicorp/go-plugin"
+
+	bindingsproto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	configurationproto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	middlewareproto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+	nameresolutionproto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	pubsubproto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	secretstoresproto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
+	stateproto "github.com/dapr/dapr/pkg/proto/state/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+	bindings_sdk "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	configuration_sdk "github.com/dapr/dapr/pkg/sdk/configuration/v1"
+	middleware_sdk "github.com/dapr/dapr/pkg/sdk/middleware/v1"
+	nameresolution_sdk "github.com/dapr/dapr/pkg/sdk/nameresolution/v1"
+	pubsub_sdk "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
+	secretstores_sdk "github.com/dapr/dapr/pkg/sdk/secretstores/v1"
+	state_sdk "github.com/dapr/dapr/pkg/sdk/state/v1"
+)
+
+// Component types served by plugins, as listed in the components of the plugin resource.
+const (
+	ComponentTypeState          = "state"
+	ComponentTypePubSub         = "pubsub"
+	ComponentTypeBindings       = "bindings"
+	ComponentTypeSecretStores   = "secretstores"
+	ComponentTypeConfiguration  = "configuration"
+	ComponentTypeMiddleware     = "middleware"
+	ComponentTypeNameResolution = "nameresolution"
+)
+
+// componentServices maps the component types to the grpc services that serve them. A component type is served when any of its services is.
+var componentServices = map[string][]string{
+	ComponentTypeState:          {stateproto.Store_ServiceDesc.ServiceName},
+	ComponentTypePubSub:         {pubsubproto.PubSub_ServiceDesc.ServiceName},
+	ComponentTypeBindings:       {bindingsproto.InputBinding_ServiceDesc.ServiceName, bindingsproto.OutputBinding_ServiceDesc.ServiceName},
+	ComponentTypeSecretStores:   {secretstoresproto.SecretStore_ServiceDesc.ServiceName},
+	ComponentTypeConfiguration:  {configurationproto.ConfigurationStore_ServiceDesc.ServiceName},
+	ComponentTypeMiddleware:     {middlewareproto.HTTPMiddleware_ServiceDesc.ServiceName},
+	ComponentTypeNameResolution: {nameresolutionproto.Resolver_ServiceDesc.ServiceName},
+}
+
+// versionedPlugins returns the plugin sets the runtime can negotiate with a plugin process, by protocol version.
+func versionedPlugins() map[int]goplugin.PluginSet {
+	return map[int]goplugin.PluginSet{
+		sdk.ProtocolVersion1: mergePluginSets(state_sdk.PluginMap),
+		sdk.ProtocolVersion2: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap),
+		sdk.ProtocolVersion3: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap, bindings_sdk.PluginMap),
+		sdk.ProtocolVersion4: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap, bindings_sdk.PluginMap, secretstores_sdk.PluginMap),
+		sdk.ProtocolVersion5: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap, bindings_sdk.PluginMap, secretstores_sdk.PluginMap, configuration_sdk.PluginMap),
+		sdk.ProtocolVersion6: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap, bindings_sdk.PluginMap, secretstores_sdk.PluginMap, configuration_sdk.PluginMap, middleware_sdk.PluginMap),
+		sdk.ProtocolVersion7: mergePluginSets(state_sdk.PluginMap, pubsub_sdk.PluginMap, bindings_sdk.PluginMap, secretstores_sdk.PluginMap, configuration_sdk.PluginMap, middleware_sdk.PluginMap, nameresolution_sdk.PluginMap),
+	}
+}
+
+func mergePluginSets(pluginSets ...goplugin.PluginSet) goplugin.PluginSet {
+	merged := goplugin.PluginSet{}
+	for _, s := range pluginSets {
+		for k, v := range s {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// servesComponentType returns true if any service of the component type is served.
+func servesComponentType(services map[string]bool, componentType string) bool {
+	for _, service := range componentServices[componentType] {
+		if services[service] {
+			return true
+		}
+	}
+	return false
+}
+
+// servedComponentTypes returns the sorted component types of the services.
+func servedComponentTypes(services map[string]bool) []string {
+	types := []string{}
+	for componentType := range componentServices {
+		if servesComponentType(services, componentType) {
+			types = append(types, componentType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// verifyComponentTypes returns an error unless the plugin process serves every component type of the plugin.
+func (p *Plugin) verifyComponentTypes(services map[string]bool, protocolVersion int) error {
+	for _, componentType := range p.cfg.ComponentTypes {
+		if _, ok := componentServices[componentType]; !ok {
+			return fmt.Errorf("plugin %s/%s: component type %s cannot be served by a plugin", p.cfg.Name, p.cfg.Version, componentType)
+		}
+		if p.manifest != nil && !p.manifest.Declares(componentType) {
+			return fmt.Errorf("plugin %s/%s: the manifest does not declare %s components (declares: %s)",
+				p.cfg.Name, p.cfg.Version, componentType, strings.Join(p.manifest.ComponentTypes, ", "))
+		}
+		if !servesComponentType(services, componentType) {
+			return fmt.Errorf("plugin %s/%s is incompatible: it does not serve %s components (protocol version %d, serves: %s)",
+				p.cfg.Name, p.cfg.Version, componentType, protocolVersion, strings.Join(servedComponentTypes(services), ", "))
+		}
+	}
+	return nil
+}
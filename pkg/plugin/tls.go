@@ -0,0 +1,22 @@
+package plugin
+
+// TLS modes of the connections to plugins that are reached over the network.
+const (
+	// TLSModeInsecure connects to the plugin without transport security. It is the default mode.
+	TLSModeInsecure = "insecure"
+	// TLSModeTLS verifies the certificate of the plugin with the configured CA or with the system roots.
+	TLSModeTLS = "tls"
+	// TLSModeMTLS authenticates both sides with Sentry-issued workload certificates, like the traffic between Dapr sidecars.
+	TLSModeMTLS = "mtls"
+)
+
+// TLSConfig configures the transport security of the connection to a plugin.
+type TLSConfig struct {
+	Mode string
+	// CACert is the PEM encoded CA certificate that verifies the plugin in tls mode. The system roots are used when empty
+	CACert string
+	// ServerName overrides the name verified in the certificate of the plugin
+	ServerName string
+	// AppID is the Dapr ID of the plugin verified in mtls mode
+	AppID string
+}
@@ -1,3 +1,15 @@
 package plugin
 
-const TypeGRPC string = "GRPC"
+// Plugin types, which select how the runtime runs and connects to a plugin.
+const (
+	// TypeGRPC plugins are launched by the runtime in standalone mode and run as a sidecar container in kubernetes mode
+	TypeGRPC string = "GRPC"
+	// TypeExec plugins are executables launched by the runtime
+	TypeExec string = "exec"
+	// TypeContainer plugins run as a container next to the runtime
+	TypeContainer string = "container"
+	// TypeRemoteAddress plugins are already running and listen on a remote address
+	TypeRemoteAddress string = "remote-address"
+	// TypeService plugins are deployed as a Kubernetes service named after the plugin and its version, located through DNS
+	TypeService string = "service"
+)
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package bindings
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// OutputBindingClient is the client API for OutputBinding service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OutputBindingClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+	Operations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OperationsResponse, error)
+}
+
+type outputBindingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOutputBindingClient(cc grpc.ClientConnInterface) OutputBindingClient {
+	return &outputBindingClient{cc}
+}
+
+func (c *outputBindingClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.bindings.v1.OutputBinding/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outputBindingClient) Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error) {
+	out := new(InvokeResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.bindings.v1.OutputBinding/Invoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *outputBindingClient) Operations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OperationsResponse, error) {
+	out := new(OperationsResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.bindings.v1.OutputBinding/Operations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OutputBindingServer is the server API for OutputBinding service.
+// All implementations should embed UnimplementedOutputBindingServer
+// for forward compatibility
+type OutputBindingServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
+	Operations(context.Context, *emptypb.Empty) (*OperationsResponse, error)
+}
+
+// UnimplementedOutputBindingServer should be embedded to have forward compatible implementations.
+type UnimplementedOutputBindingServer struct {
+}
+
+func (UnimplementedOutputBindingServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedOutputBindingServer) Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedOutputBindingServer) Operations(context.Context, *emptypb.Empty) (*OperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operations not implemented")
+}
+
+// UnsafeOutputBindingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OutputBindingServer will
+// result in compilation errors.
+type UnsafeOutputBindingServer interface {
+	mustEmbedUnimplementedOutputBindingServer()
+}
+
+func RegisterOutputBindingServer(s grpc.ServiceRegistrar, srv OutputBindingServer) {
+	s.RegisterService(&OutputBinding_ServiceDesc, srv)
+}
+
+func _OutputBinding_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutputBindingServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.bindings.v1.OutputBinding/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutputBindingServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutputBinding_Invoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutputBindingServer).Invoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.bindings.v1.OutputBinding/Invoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutputBindingServer).Invoke(ctx, req.(*InvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OutputBinding_Operations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OutputBindingServer).Operations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.bindings.v1.OutputBinding/Operations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OutputBindingServer).Operations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OutputBinding_ServiceDesc is the grpc.ServiceDesc for OutputBinding service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OutputBinding_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.bindings.v1.OutputBinding",
+	HandlerType: (*OutputBindingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _OutputBinding_Init_Handler,
+		},
+		{
+			MethodName: "Invoke",
+			Handler:    _OutputBinding_Invoke_Handler,
+		},
+		{
+			MethodName: "Operations",
+			Handler:    _OutputBinding_Operations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/bindings/v1/bindings.proto",
+}
+
+// InputBindingClient is the client API for InputBinding service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InputBindingClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Read streams every event triggered by the binding to the caller, which
+	// responds to it with a ReadAck carrying the same id.
+	// The stream ends when the binding stops reading.
+	Read(ctx context.Context, opts ...grpc.CallOption) (InputBinding_ReadClient, error)
+}
+
+type inputBindingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInputBindingClient(cc grpc.ClientConnInterface) InputBindingClient {
+	return &inputBindingClient{cc}
+}
+
+func (c *inputBindingClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.bindings.v1.InputBinding/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inputBindingClient) Read(ctx context.Context, opts ...grpc.CallOption) (InputBinding_ReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &InputBinding_ServiceDesc.Streams[0], "/dapr.proto.bindings.v1.InputBinding/Read", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &inputBindingReadClient{stream}
+	return x, nil
+}
+
+type InputBinding_ReadClient interface {
+	Send(*ReadAck) error
+	Recv() (*ReadEvent, error)
+	grpc.ClientStream
+}
+
+type inputBindingReadClient struct {
+	grpc.ClientStream
+}
+
+func (x *inputBindingReadClient) Send(m *ReadAck) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *inputBindingReadClient) Recv() (*ReadEvent, error) {
+	m := new(ReadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InputBindingServer is the server API for InputBinding service.
+// All implementations should embed UnimplementedInputBindingServer
+// for forward compatibility
+type InputBindingServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	// Read streams every event triggered by the binding to the caller, which
+	// responds to it with a ReadAck carrying the same id.
+	// The stream ends when the binding stops reading.
+	Read(InputBinding_ReadServer) error
+}
+
+// UnimplementedInputBindingServer should be embedded to have forward compatible implementations.
+type UnimplementedInputBindingServer struct {
+}
+
+func (UnimplementedInputBindingServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedInputBindingServer) Read(InputBinding_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+
+// UnsafeInputBindingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InputBindingServer will
+// result in compilation errors.
+type UnsafeInputBindingServer interface {
+	mustEmbedUnimplementedInputBindingServer()
+}
+
+func RegisterInputBindingServer(s grpc.ServiceRegistrar, srv InputBindingServer) {
+	s.RegisterService(&InputBinding_ServiceDesc, srv)
+}
+
+func _InputBinding_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InputBindingServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.bindings.v1.InputBinding/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InputBindingServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InputBinding_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InputBindingServer).Read(&inputBindingReadServer{stream})
+}
+
+type InputBinding_ReadServer interface {
+	Send(*ReadEvent) error
+	Recv() (*ReadAck, error)
+	grpc.ServerStream
+}
+
+type inputBindingReadServer struct {
+	grpc.ServerStream
+}
+
+func (x *inputBindingReadServer) Send(m *ReadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *inputBindingReadServer) Recv() (*ReadAck, error) {
+	m := new(ReadAck)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// InputBinding_ServiceDesc is the grpc.ServiceDesc for InputBinding service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InputBinding_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.bindings.v1.InputBinding",
+	HandlerType: (*InputBindingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _InputBinding_Init_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Read",
+			Handler:       _InputBinding_Read_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "dapr/proto/bindings/v1/bindings.proto",
+}
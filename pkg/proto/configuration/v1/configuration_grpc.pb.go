@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package configuration
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ConfigurationStoreClient is the client API for ConfigurationStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigurationStoreClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Subscribe streams the updates of the keys to the caller until the caller
+	// goes away. The plugin sends the headers once the subscription is in place.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ConfigurationStore_SubscribeClient, error)
+}
+
+type configurationStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigurationStoreClient(cc grpc.ClientConnInterface) ConfigurationStoreClient {
+	return &configurationStoreClient{cc}
+}
+
+func (c *configurationStoreClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.configuration.v1.ConfigurationStore/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationStoreClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.configuration.v1.ConfigurationStore/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationStoreClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ConfigurationStore_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigurationStore_ServiceDesc.Streams[0], "/dapr.proto.configuration.v1.ConfigurationStore/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configurationStoreSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigurationStore_SubscribeClient interface {
+	Recv() (*UpdateEvent, error)
+	grpc.ClientStream
+}
+
+type configurationStoreSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *configurationStoreSubscribeClient) Recv() (*UpdateEvent, error) {
+	m := new(UpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ConfigurationStoreServer is the server API for ConfigurationStore service.
+// All implementations should embed UnimplementedConfigurationStoreServer
+// for forward compatibility
+type ConfigurationStoreServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Subscribe streams the updates of the keys to the caller until the caller
+	// goes away. The plugin sends the headers once the subscription is in place.
+	Subscribe(*SubscribeRequest, ConfigurationStore_SubscribeServer) error
+}
+
+// UnimplementedConfigurationStoreServer should be embedded to have forward compatible implementations.
+type UnimplementedConfigurationStoreServer struct {
+}
+
+func (UnimplementedConfigurationStoreServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Subscribe(*SubscribeRequest, ConfigurationStore_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+// UnsafeConfigurationStoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigurationStoreServer will
+// result in compilation errors.
+type UnsafeConfigurationStoreServer interface {
+	mustEmbedUnimplementedConfigurationStoreServer()
+}
+
+func RegisterConfigurationStoreServer(s grpc.ServiceRegistrar, srv ConfigurationStoreServer) {
+	s.RegisterService(&ConfigurationStore_ServiceDesc, srv)
+}
+
+func _ConfigurationStore_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.configuration.v1.ConfigurationStore/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationStore_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.configuration.v1.ConfigurationStore/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationStore_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigurationStoreServer).Subscribe(m, &configurationStoreSubscribeServer{stream})
+}
+
+type ConfigurationStore_SubscribeServer interface {
+	Send(*UpdateEvent) error
+	grpc.ServerStream
+}
+
+type configurationStoreSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *configurationStoreSubscribeServer) Send(m *UpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ConfigurationStore_ServiceDesc is the grpc.ServiceDesc for ConfigurationStore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigurationStore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.configuration.v1.ConfigurationStore",
+	HandlerType: (*ConfigurationStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _ConfigurationStore_Init_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ConfigurationStore_Get_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ConfigurationStore_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dapr/proto/configuration/v1/configuration.proto",
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package middleware
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HTTPMiddlewareClient is the client API for HTTPMiddleware service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HTTPMiddlewareClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Handle is called for every http request going through the middleware.
+	Handle(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HandleResponse, error)
+}
+
+type hTTPMiddlewareClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHTTPMiddlewareClient(cc grpc.ClientConnInterface) HTTPMiddlewareClient {
+	return &hTTPMiddlewareClient{cc}
+}
+
+func (c *hTTPMiddlewareClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.middleware.v1.HTTPMiddleware/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hTTPMiddlewareClient) Handle(ctx context.Context, in *HTTPRequest, opts ...grpc.CallOption) (*HandleResponse, error) {
+	out := new(HandleResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.middleware.v1.HTTPMiddleware/Handle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HTTPMiddlewareServer is the server API for HTTPMiddleware service.
+// All implementations should embed UnimplementedHTTPMiddlewareServer
+// for forward compatibility
+type HTTPMiddlewareServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	// Handle is called for every http request going through the middleware.
+	Handle(context.Context, *HTTPRequest) (*HandleResponse, error)
+}
+
+// UnimplementedHTTPMiddlewareServer should be embedded to have forward compatible implementations.
+type UnimplementedHTTPMiddlewareServer struct {
+}
+
+func (UnimplementedHTTPMiddlewareServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedHTTPMiddlewareServer) Handle(context.Context, *HTTPRequest) (*HandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
+}
+
+// UnsafeHTTPMiddlewareServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HTTPMiddlewareServer will
+// result in compilation errors.
+type UnsafeHTTPMiddlewareServer interface {
+	mustEmbedUnimplementedHTTPMiddlewareServer()
+}
+
+func RegisterHTTPMiddlewareServer(s grpc.ServiceRegistrar, srv HTTPMiddlewareServer) {
+	s.RegisterService(&HTTPMiddleware_ServiceDesc, srv)
+}
+
+func _HTTPMiddleware_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPMiddlewareServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.middleware.v1.HTTPMiddleware/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPMiddlewareServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HTTPMiddleware_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HTTPMiddlewareServer).Handle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.middleware.v1.HTTPMiddleware/Handle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HTTPMiddlewareServer).Handle(ctx, req.(*HTTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HTTPMiddleware_ServiceDesc is the grpc.ServiceDesc for HTTPMiddleware service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HTTPMiddleware_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.middleware.v1.HTTPMiddleware",
+	HandlerType: (*HTTPMiddlewareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _HTTPMiddleware_Init_Handler,
+		},
+		{
+			MethodName: "Handle",
+			Handler:    _HTTPMiddleware_Handle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/middleware/v1/middleware.proto",
+}
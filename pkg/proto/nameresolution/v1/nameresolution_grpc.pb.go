@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package nameresolution
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ResolverClient is the client API for Resolver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResolverClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveID(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
+}
+
+type resolverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResolverClient(cc grpc.ClientConnInterface) ResolverClient {
+	return &resolverClient{cc}
+}
+
+func (c *resolverClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.nameresolution.v1.Resolver/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resolverClient) ResolveID(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
+	out := new(ResolveResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.nameresolution.v1.Resolver/ResolveID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResolverServer is the server API for Resolver service.
+// All implementations should embed UnimplementedResolverServer
+// for forward compatibility
+type ResolverServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	ResolveID(context.Context, *ResolveRequest) (*ResolveResponse, error)
+}
+
+// UnimplementedResolverServer should be embedded to have forward compatible implementations.
+type UnimplementedResolverServer struct {
+}
+
+func (UnimplementedResolverServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedResolverServer) ResolveID(context.Context, *ResolveRequest) (*ResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveID not implemented")
+}
+
+// UnsafeResolverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResolverServer will
+// result in compilation errors.
+type UnsafeResolverServer interface {
+	mustEmbedUnimplementedResolverServer()
+}
+
+func RegisterResolverServer(s grpc.ServiceRegistrar, srv ResolverServer) {
+	s.RegisterService(&Resolver_ServiceDesc, srv)
+}
+
+func _Resolver_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResolverServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.nameresolution.v1.Resolver/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResolverServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Resolver_ResolveID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResolverServer).ResolveID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.nameresolution.v1.Resolver/ResolveID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResolverServer).ResolveID(ctx, req.(*ResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Resolver_ServiceDesc is the grpc.ServiceDesc for Resolver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Resolver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.nameresolution.v1.Resolver",
+	HandlerType: (*ResolverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _Resolver_Init_Handler,
+		},
+		{
+			MethodName: "ResolveID",
+			Handler:    _Resolver_ResolveID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/nameresolution/v1/nameresolution.proto",
+}
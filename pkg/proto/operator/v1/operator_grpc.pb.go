@@ -27,6 +27,10 @@ type OperatorClient interface {
 	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
 	// Returns a list of pub/sub subscriptions
 	ListSubscriptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// Returns a list of available plugins
+	ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	// Sends events to Dapr sidecars upon plugin changes.
+	PluginUpdate(ctx context.Context, in *PluginUpdateRequest, opts ...grpc.CallOption) (Operator_PluginUpdateClient, error)
 }
 
 type operatorClient struct {
@@ -96,6 +100,47 @@ func (c *operatorClient) ListSubscriptions(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *operatorClient) ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.operator.v1.Operator/ListPlugins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) PluginUpdate(ctx context.Context, in *PluginUpdateRequest, opts ...grpc.CallOption) (Operator_PluginUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[1], "/dapr.proto.operator.v1.Operator/PluginUpdate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorPluginUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_PluginUpdateClient interface {
+	Recv() (*PluginUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type operatorPluginUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorPluginUpdateClient) Recv() (*PluginUpdateEvent, error) {
+	m := new(PluginUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OperatorServer is the server API for Operator service.
 // All implementations should embed UnimplementedOperatorServer
 // for forward compatibility
@@ -108,6 +153,10 @@ type OperatorServer interface {
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
 	// Returns a list of pub/sub subscriptions
 	ListSubscriptions(context.Context, *emptypb.Empty) (*ListSubscriptionsResponse, error)
+	// Returns a list of available plugins
+	ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error)
+	// Sends events to Dapr sidecars upon plugin changes.
+	PluginUpdate(*PluginUpdateRequest, Operator_PluginUpdateServer) error
 }
 
 // UnimplementedOperatorServer should be embedded to have forward compatible implementations.
@@ -126,6 +175,12 @@ func (UnimplementedOperatorServer) GetConfiguration(context.Context, *GetConfigu
 func (UnimplementedOperatorServer) ListSubscriptions(context.Context, *emptypb.Empty) (*ListSubscriptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
 }
+func (UnimplementedOperatorServer) ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlugins not implemented")
+}
+func (UnimplementedOperatorServer) PluginUpdate(*PluginUpdateRequest, Operator_PluginUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method PluginUpdate not implemented")
+}
 
 // UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OperatorServer will
@@ -213,6 +268,45 @@ func _Operator_ListSubscriptions_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Operator_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.operator.v1.Operator/ListPlugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ListPlugins(ctx, req.(*ListPluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_PluginUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PluginUpdateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).PluginUpdate(m, &operatorPluginUpdateServer{stream})
+}
+
+type Operator_PluginUpdateServer interface {
+	Send(*PluginUpdateEvent) error
+	grpc.ServerStream
+}
+
+type operatorPluginUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorPluginUpdateServer) Send(m *PluginUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Operator_ServiceDesc is the grpc.ServiceDesc for Operator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -232,6 +326,10 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscriptions",
 			Handler:    _Operator_ListSubscriptions_Handler,
 		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _Operator_ListPlugins_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -239,6 +337,11 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Operator_ComponentUpdate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PluginUpdate",
+			Handler:       _Operator_PluginUpdate_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/operator/v1/operator.proto",
 }
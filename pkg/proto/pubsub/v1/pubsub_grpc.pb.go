@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pubsub
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PubSubClient is the client API for PubSub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PubSubClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Features(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeaturesResponse, error)
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Subscribe opens a subscription for the topic in the first request.
+	// Every message received on the topic is streamed back to the caller, which
+	// acknowledges it with a MessageAck carrying the same id.
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (PubSub_SubscribeClient, error)
+}
+
+type pubSubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPubSubClient(cc grpc.ClientConnInterface) PubSubClient {
+	return &pubSubClient{cc}
+}
+
+func (c *pubSubClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.pubsub.v1.PubSub/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pubSubClient) Features(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FeaturesResponse, error) {
+	out := new(FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.pubsub.v1.PubSub/Features", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pubSubClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.pubsub.v1.PubSub/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pubSubClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (PubSub_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PubSub_ServiceDesc.Streams[0], "/dapr.proto.pubsub.v1.PubSub/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pubSubSubscribeClient{stream}
+	return x, nil
+}
+
+type PubSub_SubscribeClient interface {
+	Send(*SubscribeStreamRequest) error
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type pubSubSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *pubSubSubscribeClient) Send(m *SubscribeStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pubSubSubscribeClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PubSubServer is the server API for PubSub service.
+// All implementations should embed UnimplementedPubSubServer
+// for forward compatibility
+type PubSubServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	Features(context.Context, *emptypb.Empty) (*FeaturesResponse, error)
+	Publish(context.Context, *PublishRequest) (*emptypb.Empty, error)
+	// Subscribe opens a subscription for the topic in the first request.
+	// Every message received on the topic is streamed back to the caller, which
+	// acknowledges it with a MessageAck carrying the same id.
+	Subscribe(PubSub_SubscribeServer) error
+}
+
+// UnimplementedPubSubServer should be embedded to have forward compatible implementations.
+type UnimplementedPubSubServer struct {
+}
+
+func (UnimplementedPubSubServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedPubSubServer) Features(context.Context, *emptypb.Empty) (*FeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Features not implemented")
+}
+func (UnimplementedPubSubServer) Publish(context.Context, *PublishRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedPubSubServer) Subscribe(PubSub_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+// UnsafePubSubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PubSubServer will
+// result in compilation errors.
+type UnsafePubSubServer interface {
+	mustEmbedUnimplementedPubSubServer()
+}
+
+func RegisterPubSubServer(s grpc.ServiceRegistrar, srv PubSubServer) {
+	s.RegisterService(&PubSub_ServiceDesc, srv)
+}
+
+func _PubSub_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PubSubServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.pubsub.v1.PubSub/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PubSubServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PubSub_Features_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PubSubServer).Features(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.pubsub.v1.PubSub/Features",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PubSubServer).Features(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PubSub_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PubSubServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.pubsub.v1.PubSub/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PubSubServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PubSub_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PubSubServer).Subscribe(&pubSubSubscribeServer{stream})
+}
+
+type PubSub_SubscribeServer interface {
+	Send(*Message) error
+	Recv() (*SubscribeStreamRequest, error)
+	grpc.ServerStream
+}
+
+type pubSubSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *pubSubSubscribeServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pubSubSubscribeServer) Recv() (*SubscribeStreamRequest, error) {
+	m := new(SubscribeStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PubSub_ServiceDesc is the grpc.ServiceDesc for PubSub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PubSub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.pubsub.v1.PubSub",
+	HandlerType: (*PubSubServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _PubSub_Init_Handler,
+		},
+		{
+			MethodName: "Features",
+			Handler:    _PubSub_Features_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _PubSub_Publish_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _PubSub_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "dapr/proto/pubsub/v1/pubsub.proto",
+}
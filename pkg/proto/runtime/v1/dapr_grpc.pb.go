@@ -54,6 +54,10 @@ type DaprClient interface {
 	UnregisterActorReminder(ctx context.Context, in *UnregisterActorReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Rename an actor reminder.
 	RenameActorReminder(ctx context.Context, in *RenameActorReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the reminders of an actor type or of an actor.
+	ListActorReminders(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error)
+	// Lists the active timers of an actor type or of an actor on this host.
+	ListActorTimers(ctx context.Context, in *ListActorTimersRequest, opts ...grpc.CallOption) (*ListActorTimersResponse, error)
 	// Gets the state for a specific actor.
 	GetActorState(ctx context.Context, in *GetActorStateRequest, opts ...grpc.CallOption) (*GetActorStateResponse, error)
 	// Executes state transactions for a specified actor
@@ -233,6 +237,24 @@ func (c *daprClient) RenameActorReminder(ctx context.Context, in *RenameActorRem
 	return out, nil
 }
 
+func (c *daprClient) ListActorReminders(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error) {
+	out := new(ListActorRemindersResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ListActorReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ListActorTimers(ctx context.Context, in *ListActorTimersRequest, opts ...grpc.CallOption) (*ListActorTimersResponse, error) {
+	out := new(ListActorTimersResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ListActorTimers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) GetActorState(ctx context.Context, in *GetActorStateRequest, opts ...grpc.CallOption) (*GetActorStateResponse, error) {
 	out := new(GetActorStateResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetActorState", in, out, opts...)
@@ -366,6 +388,10 @@ type DaprServer interface {
 	UnregisterActorReminder(context.Context, *UnregisterActorReminderRequest) (*emptypb.Empty, error)
 	// Rename an actor reminder.
 	RenameActorReminder(context.Context, *RenameActorReminderRequest) (*emptypb.Empty, error)
+	// Lists the reminders of an actor type or of an actor.
+	ListActorReminders(context.Context, *ListActorRemindersRequest) (*ListActorRemindersResponse, error)
+	// Lists the active timers of an actor type or of an actor on this host.
+	ListActorTimers(context.Context, *ListActorTimersRequest) (*ListActorTimersResponse, error)
 	// Gets the state for a specific actor.
 	GetActorState(context.Context, *GetActorStateRequest) (*GetActorStateResponse, error)
 	// Executes state transactions for a specified actor
@@ -439,6 +465,12 @@ func (UnimplementedDaprServer) UnregisterActorReminder(context.Context, *Unregis
 func (UnimplementedDaprServer) RenameActorReminder(context.Context, *RenameActorReminderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameActorReminder not implemented")
 }
+func (UnimplementedDaprServer) ListActorReminders(context.Context, *ListActorRemindersRequest) (*ListActorRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActorReminders not implemented")
+}
+func (UnimplementedDaprServer) ListActorTimers(context.Context, *ListActorTimersRequest) (*ListActorTimersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActorTimers not implemented")
+}
 func (UnimplementedDaprServer) GetActorState(context.Context, *GetActorStateRequest) (*GetActorStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActorState not implemented")
 }
@@ -781,6 +813,42 @@ func _Dapr_RenameActorReminder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListActorReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActorRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListActorReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ListActorReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListActorReminders(ctx, req.(*ListActorRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ListActorTimers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActorTimersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListActorTimers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ListActorTimers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListActorTimers(ctx, req.(*ListActorTimersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_GetActorState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetActorStateRequest)
 	if err := dec(in); err != nil {
@@ -1003,6 +1071,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameActorReminder",
 			Handler:    _Dapr_RenameActorReminder_Handler,
 		},
+		{
+			MethodName: "ListActorReminders",
+			Handler:    _Dapr_ListActorReminders_Handler,
+		},
+		{
+			MethodName: "ListActorTimers",
+			Handler:    _Dapr_ListActorTimers_Handler,
+		},
 		{
 			MethodName: "GetActorState",
 			Handler:    _Dapr_GetActorState_Handler,
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package secretstores
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SecretStoreClient is the client API for SecretStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SecretStoreClient interface {
+	Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	BulkGetSecret(ctx context.Context, in *BulkGetSecretRequest, opts ...grpc.CallOption) (*BulkGetSecretResponse, error)
+}
+
+type secretStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSecretStoreClient(cc grpc.ClientConnInterface) SecretStoreClient {
+	return &secretStoreClient{cc}
+}
+
+func (c *secretStoreClient) Init(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.secretstores.v1.SecretStore/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretStoreClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
+	out := new(GetSecretResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.secretstores.v1.SecretStore/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretStoreClient) BulkGetSecret(ctx context.Context, in *BulkGetSecretRequest, opts ...grpc.CallOption) (*BulkGetSecretResponse, error) {
+	out := new(BulkGetSecretResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.secretstores.v1.SecretStore/BulkGetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecretStoreServer is the server API for SecretStore service.
+// All implementations should embed UnimplementedSecretStoreServer
+// for forward compatibility
+type SecretStoreServer interface {
+	Init(context.Context, *MetadataRequest) (*emptypb.Empty, error)
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	BulkGetSecret(context.Context, *BulkGetSecretRequest) (*BulkGetSecretResponse, error)
+}
+
+// UnimplementedSecretStoreServer should be embedded to have forward compatible implementations.
+type UnimplementedSecretStoreServer struct {
+}
+
+func (UnimplementedSecretStoreServer) Init(context.Context, *MetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedSecretStoreServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedSecretStoreServer) BulkGetSecret(context.Context, *BulkGetSecretRequest) (*BulkGetSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkGetSecret not implemented")
+}
+
+// UnsafeSecretStoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecretStoreServer will
+// result in compilation errors.
+type UnsafeSecretStoreServer interface {
+	mustEmbedUnimplementedSecretStoreServer()
+}
+
+func RegisterSecretStoreServer(s grpc.ServiceRegistrar, srv SecretStoreServer) {
+	s.RegisterService(&SecretStore_ServiceDesc, srv)
+}
+
+func _SecretStore_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretStoreServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.secretstores.v1.SecretStore/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretStoreServer).Init(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretStore_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretStoreServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.secretstores.v1.SecretStore/GetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretStoreServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretStore_BulkGetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkGetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretStoreServer).BulkGetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.secretstores.v1.SecretStore/BulkGetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretStoreServer).BulkGetSecret(ctx, req.(*BulkGetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SecretStore_ServiceDesc is the grpc.ServiceDesc for SecretStore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SecretStore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.secretstores.v1.SecretStore",
+	HandlerType: (*SecretStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _SecretStore_Init_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _SecretStore_GetSecret_Handler,
+		},
+		{
+			MethodName: "BulkGetSecret",
+			Handler:    _SecretStore_BulkGetSecret_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/secretstores/v1/secretstores.proto",
+}
@@ -679,6 +679,331 @@ func (x *BulkSetRequest) GetItems() []*SetRequest {
 	return nil
 }
 
+type TransactionalStateOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*TransactionalStateOperation_Set
+	//	*TransactionalStateOperation_Delete
+	Request isTransactionalStateOperation_Request `protobuf_oneof:"request"`
+}
+
+func (x *TransactionalStateOperation) Reset() {
+	*x = TransactionalStateOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_state_v1_state_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionalStateOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionalStateOperation) ProtoMessage() {}
+
+func (x *TransactionalStateOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_state_v1_state_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionalStateOperation.ProtoReflect.Descriptor instead.
+func (*TransactionalStateOperation) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_state_v1_state_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *TransactionalStateOperation) GetRequest() isTransactionalStateOperation_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *TransactionalStateOperation) GetSet() *SetRequest {
+	if x, ok := x.GetRequest().(*TransactionalStateOperation_Set); ok {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *TransactionalStateOperation) GetDelete() *DeleteRequest {
+	if x, ok := x.GetRequest().(*TransactionalStateOperation_Delete); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+type isTransactionalStateOperation_Request interface {
+	isTransactionalStateOperation_Request()
+}
+
+type TransactionalStateOperation_Set struct {
+	Set *SetRequest `protobuf:"bytes,1,opt,name=set,proto3,oneof"`
+}
+
+type TransactionalStateOperation_Delete struct {
+	Delete *DeleteRequest `protobuf:"bytes,2,opt,name=delete,proto3,oneof"`
+}
+
+func (*TransactionalStateOperation_Set) isTransactionalStateOperation_Request() {}
+
+func (*TransactionalStateOperation_Delete) isTransactionalStateOperation_Request() {}
+
+type TransactionalStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*TransactionalStateOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	Metadata   map[string]string              `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TransactionalStateRequest) Reset() {
+	*x = TransactionalStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_state_v1_state_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionalStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionalStateRequest) ProtoMessage() {}
+
+func (x *TransactionalStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_state_v1_state_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionalStateRequest.ProtoReflect.Descriptor instead.
+func (*TransactionalStateRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_state_v1_state_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransactionalStateRequest) GetOperations() []*TransactionalStateOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionalStateRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// query is the JSON encoded query with its filter, sort and page sections.
+	Query    string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_state_v1_state_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_state_v1_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_state_v1_state_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type QueryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data  []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Etag  *v1.Etag `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Error string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *QueryItem) Reset() {
+	*x = QueryItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_state_v1_state_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryItem) ProtoMessage() {}
+
+func (x *QueryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_state_v1_state_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryItem.ProtoReflect.Descriptor instead.
+func (*QueryItem) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_state_v1_state_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *QueryItem) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QueryItem) GetEtag() *v1.Etag {
+	if x != nil {
+		return x.Etag
+	}
+	return nil
+}
+
+func (x *QueryItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*QueryItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Token    string            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_state_v1_state_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_state_v1_state_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_state_v1_state_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryResponse) GetItems() []*QueryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 var File_dapr_proto_state_v1_state_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_state_v1_state_proto_rawDesc = []byte{
@@ -805,53 +1130,123 @@ var file_dapr_proto_state_v1_state_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0x9e, 0x05,
-	0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12,
-	0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x06,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x64, 0x61,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x9b, 0x01,
+	0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x73,
+	0x65, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x19,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61,
 	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x61, 0x67,
+	0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe6, 0x01, 0x0a,
+	0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64,
 	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x38, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a,
-	0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xc3, 0x06, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
+	0x46, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x07,
-	0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
-	0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64,
-	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x07, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x65, 0x74, 0x12,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12,
+	0x1f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x04, 0x50, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x07, 0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x12,
 	0x23, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2f,
-	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
-	0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x07,
+	0x42, 0x75, 0x6c, 0x6b, 0x53, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x05, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12,
+	0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64,
+	0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -866,7 +1261,7 @@ func file_dapr_proto_state_v1_state_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_state_v1_state_proto_rawDescData
 }
 
-var file_dapr_proto_state_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_dapr_proto_state_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_dapr_proto_state_v1_state_proto_goTypes = []interface{}{
 	(*MetadataRequest)(nil),               // 0: dapr.proto.state.v1.MetadataRequest
 	(*FeaturesResponse)(nil),              // 1: dapr.proto.state.v1.FeaturesResponse
@@ -879,58 +1274,78 @@ var file_dapr_proto_state_v1_state_proto_goTypes = []interface{}{
 	(*BulkStateItem)(nil),                 // 8: dapr.proto.state.v1.BulkStateItem
 	(*BulkGetResponse)(nil),               // 9: dapr.proto.state.v1.BulkGetResponse
 	(*BulkSetRequest)(nil),                // 10: dapr.proto.state.v1.BulkSetRequest
-	nil,                                   // 11: dapr.proto.state.v1.MetadataRequest.PropertiesEntry
-	nil,                                   // 12: dapr.proto.state.v1.GetRequest.MetadataEntry
-	nil,                                   // 13: dapr.proto.state.v1.GetResponse.MetadataEntry
-	nil,                                   // 14: dapr.proto.state.v1.DeleteRequest.MetadataEntry
-	nil,                                   // 15: dapr.proto.state.v1.SetRequest.MetadataEntry
-	nil,                                   // 16: dapr.proto.state.v1.BulkStateItem.MetadataEntry
-	(v1.StateOptions_StateConsistency)(0), // 17: dapr.proto.common.v1.StateOptions.StateConsistency
-	(*v1.Etag)(nil),                       // 18: dapr.proto.common.v1.Etag
-	(*v1.StateOptions)(nil),               // 19: dapr.proto.common.v1.StateOptions
-	(*emptypb.Empty)(nil),                 // 20: google.protobuf.Empty
+	(*TransactionalStateOperation)(nil),   // 11: dapr.proto.state.v1.TransactionalStateOperation
+	(*TransactionalStateRequest)(nil),     // 12: dapr.proto.state.v1.TransactionalStateRequest
+	(*QueryRequest)(nil),                  // 13: dapr.proto.state.v1.QueryRequest
+	(*QueryItem)(nil),                     // 14: dapr.proto.state.v1.QueryItem
+	(*QueryResponse)(nil),                 // 15: dapr.proto.state.v1.QueryResponse
+	nil,                                   // 16: dapr.proto.state.v1.MetadataRequest.PropertiesEntry
+	nil,                                   // 17: dapr.proto.state.v1.GetRequest.MetadataEntry
+	nil,                                   // 18: dapr.proto.state.v1.GetResponse.MetadataEntry
+	nil,                                   // 19: dapr.proto.state.v1.DeleteRequest.MetadataEntry
+	nil,                                   // 20: dapr.proto.state.v1.SetRequest.MetadataEntry
+	nil,                                   // 21: dapr.proto.state.v1.BulkStateItem.MetadataEntry
+	nil,                                   // 22: dapr.proto.state.v1.TransactionalStateRequest.MetadataEntry
+	nil,                                   // 23: dapr.proto.state.v1.QueryRequest.MetadataEntry
+	nil,                                   // 24: dapr.proto.state.v1.QueryResponse.MetadataEntry
+	(v1.StateOptions_StateConsistency)(0), // 25: dapr.proto.common.v1.StateOptions.StateConsistency
+	(*v1.Etag)(nil),                       // 26: dapr.proto.common.v1.Etag
+	(*v1.StateOptions)(nil),               // 27: dapr.proto.common.v1.StateOptions
+	(*emptypb.Empty)(nil),                 // 28: google.protobuf.Empty
 }
 var file_dapr_proto_state_v1_state_proto_depIdxs = []int32{
-	11, // 0: dapr.proto.state.v1.MetadataRequest.properties:type_name -> dapr.proto.state.v1.MetadataRequest.PropertiesEntry
-	12, // 1: dapr.proto.state.v1.GetRequest.metadata:type_name -> dapr.proto.state.v1.GetRequest.MetadataEntry
-	17, // 2: dapr.proto.state.v1.GetRequest.consistency:type_name -> dapr.proto.common.v1.StateOptions.StateConsistency
-	18, // 3: dapr.proto.state.v1.GetResponse.etag:type_name -> dapr.proto.common.v1.Etag
-	13, // 4: dapr.proto.state.v1.GetResponse.metadata:type_name -> dapr.proto.state.v1.GetResponse.MetadataEntry
-	18, // 5: dapr.proto.state.v1.DeleteRequest.etag:type_name -> dapr.proto.common.v1.Etag
-	14, // 6: dapr.proto.state.v1.DeleteRequest.metadata:type_name -> dapr.proto.state.v1.DeleteRequest.MetadataEntry
-	19, // 7: dapr.proto.state.v1.DeleteRequest.options:type_name -> dapr.proto.common.v1.StateOptions
-	18, // 8: dapr.proto.state.v1.SetRequest.etag:type_name -> dapr.proto.common.v1.Etag
-	15, // 9: dapr.proto.state.v1.SetRequest.metadata:type_name -> dapr.proto.state.v1.SetRequest.MetadataEntry
-	19, // 10: dapr.proto.state.v1.SetRequest.options:type_name -> dapr.proto.common.v1.StateOptions
+	16, // 0: dapr.proto.state.v1.MetadataRequest.properties:type_name -> dapr.proto.state.v1.MetadataRequest.PropertiesEntry
+	17, // 1: dapr.proto.state.v1.GetRequest.metadata:type_name -> dapr.proto.state.v1.GetRequest.MetadataEntry
+	25, // 2: dapr.proto.state.v1.GetRequest.consistency:type_name -> dapr.proto.common.v1.StateOptions.StateConsistency
+	26, // 3: dapr.proto.state.v1.GetResponse.etag:type_name -> dapr.proto.common.v1.Etag
+	18, // 4: dapr.proto.state.v1.GetResponse.metadata:type_name -> dapr.proto.state.v1.GetResponse.MetadataEntry
+	26, // 5: dapr.proto.state.v1.DeleteRequest.etag:type_name -> dapr.proto.common.v1.Etag
+	19, // 6: dapr.proto.state.v1.DeleteRequest.metadata:type_name -> dapr.proto.state.v1.DeleteRequest.MetadataEntry
+	27, // 7: dapr.proto.state.v1.DeleteRequest.options:type_name -> dapr.proto.common.v1.StateOptions
+	26, // 8: dapr.proto.state.v1.SetRequest.etag:type_name -> dapr.proto.common.v1.Etag
+	20, // 9: dapr.proto.state.v1.SetRequest.metadata:type_name -> dapr.proto.state.v1.SetRequest.MetadataEntry
+	27, // 10: dapr.proto.state.v1.SetRequest.options:type_name -> dapr.proto.common.v1.StateOptions
 	4,  // 11: dapr.proto.state.v1.BulkDeleteRequest.items:type_name -> dapr.proto.state.v1.DeleteRequest
 	2,  // 12: dapr.proto.state.v1.BulkGetRequest.items:type_name -> dapr.proto.state.v1.GetRequest
-	18, // 13: dapr.proto.state.v1.BulkStateItem.etag:type_name -> dapr.proto.common.v1.Etag
-	16, // 14: dapr.proto.state.v1.BulkStateItem.metadata:type_name -> dapr.proto.state.v1.BulkStateItem.MetadataEntry
+	26, // 13: dapr.proto.state.v1.BulkStateItem.etag:type_name -> dapr.proto.common.v1.Etag
+	21, // 14: dapr.proto.state.v1.BulkStateItem.metadata:type_name -> dapr.proto.state.v1.BulkStateItem.MetadataEntry
 	8,  // 15: dapr.proto.state.v1.BulkGetResponse.items:type_name -> dapr.proto.state.v1.BulkStateItem
 	5,  // 16: dapr.proto.state.v1.BulkSetRequest.items:type_name -> dapr.proto.state.v1.SetRequest
-	0,  // 17: dapr.proto.state.v1.Store.Init:input_type -> dapr.proto.state.v1.MetadataRequest
-	20, // 18: dapr.proto.state.v1.Store.Features:input_type -> google.protobuf.Empty
-	4,  // 19: dapr.proto.state.v1.Store.Delete:input_type -> dapr.proto.state.v1.DeleteRequest
-	2,  // 20: dapr.proto.state.v1.Store.Get:input_type -> dapr.proto.state.v1.GetRequest
-	5,  // 21: dapr.proto.state.v1.Store.Set:input_type -> dapr.proto.state.v1.SetRequest
-	20, // 22: dapr.proto.state.v1.Store.Ping:input_type -> google.protobuf.Empty
-	6,  // 23: dapr.proto.state.v1.Store.BulkDelete:input_type -> dapr.proto.state.v1.BulkDeleteRequest
-	7,  // 24: dapr.proto.state.v1.Store.BulkGet:input_type -> dapr.proto.state.v1.BulkGetRequest
-	10, // 25: dapr.proto.state.v1.Store.BulkSet:input_type -> dapr.proto.state.v1.BulkSetRequest
-	20, // 26: dapr.proto.state.v1.Store.Init:output_type -> google.protobuf.Empty
-	1,  // 27: dapr.proto.state.v1.Store.Features:output_type -> dapr.proto.state.v1.FeaturesResponse
-	20, // 28: dapr.proto.state.v1.Store.Delete:output_type -> google.protobuf.Empty
-	3,  // 29: dapr.proto.state.v1.Store.Get:output_type -> dapr.proto.state.v1.GetResponse
-	20, // 30: dapr.proto.state.v1.Store.Set:output_type -> google.protobuf.Empty
-	20, // 31: dapr.proto.state.v1.Store.Ping:output_type -> google.protobuf.Empty
-	20, // 32: dapr.proto.state.v1.Store.BulkDelete:output_type -> google.protobuf.Empty
-	9,  // 33: dapr.proto.state.v1.Store.BulkGet:output_type -> dapr.proto.state.v1.BulkGetResponse
-	20, // 34: dapr.proto.state.v1.Store.BulkSet:output_type -> google.protobuf.Empty
-	26, // [26:35] is the sub-list for method output_type
-	17, // [17:26] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	5,  // 17: dapr.proto.state.v1.TransactionalStateOperation.set:type_name -> dapr.proto.state.v1.SetRequest
+	4,  // 18: dapr.proto.state.v1.TransactionalStateOperation.delete:type_name -> dapr.proto.state.v1.DeleteRequest
+	11, // 19: dapr.proto.state.v1.TransactionalStateRequest.operations:type_name -> dapr.proto.state.v1.TransactionalStateOperation
+	22, // 20: dapr.proto.state.v1.TransactionalStateRequest.metadata:type_name -> dapr.proto.state.v1.TransactionalStateRequest.MetadataEntry
+	23, // 21: dapr.proto.state.v1.QueryRequest.metadata:type_name -> dapr.proto.state.v1.QueryRequest.MetadataEntry
+	26, // 22: dapr.proto.state.v1.QueryItem.etag:type_name -> dapr.proto.common.v1.Etag
+	14, // 23: dapr.proto.state.v1.QueryResponse.items:type_name -> dapr.proto.state.v1.QueryItem
+	24, // 24: dapr.proto.state.v1.QueryResponse.metadata:type_name -> dapr.proto.state.v1.QueryResponse.MetadataEntry
+	0,  // 25: dapr.proto.state.v1.Store.Init:input_type -> dapr.proto.state.v1.MetadataRequest
+	28, // 26: dapr.proto.state.v1.Store.Features:input_type -> google.protobuf.Empty
+	4,  // 27: dapr.proto.state.v1.Store.Delete:input_type -> dapr.proto.state.v1.DeleteRequest
+	2,  // 28: dapr.proto.state.v1.Store.Get:input_type -> dapr.proto.state.v1.GetRequest
+	5,  // 29: dapr.proto.state.v1.Store.Set:input_type -> dapr.proto.state.v1.SetRequest
+	28, // 30: dapr.proto.state.v1.Store.Ping:input_type -> google.protobuf.Empty
+	6,  // 31: dapr.proto.state.v1.Store.BulkDelete:input_type -> dapr.proto.state.v1.BulkDeleteRequest
+	7,  // 32: dapr.proto.state.v1.Store.BulkGet:input_type -> dapr.proto.state.v1.BulkGetRequest
+	10, // 33: dapr.proto.state.v1.Store.BulkSet:input_type -> dapr.proto.state.v1.BulkSetRequest
+	12, // 34: dapr.proto.state.v1.Store.Multi:input_type -> dapr.proto.state.v1.TransactionalStateRequest
+	13, // 35: dapr.proto.state.v1.Store.Query:input_type -> dapr.proto.state.v1.QueryRequest
+	28, // 36: dapr.proto.state.v1.Store.Init:output_type -> google.protobuf.Empty
+	1,  // 37: dapr.proto.state.v1.Store.Features:output_type -> dapr.proto.state.v1.FeaturesResponse
+	28, // 38: dapr.proto.state.v1.Store.Delete:output_type -> google.protobuf.Empty
+	3,  // 39: dapr.proto.state.v1.Store.Get:output_type -> dapr.proto.state.v1.GetResponse
+	28, // 40: dapr.proto.state.v1.Store.Set:output_type -> google.protobuf.Empty
+	28, // 41: dapr.proto.state.v1.Store.Ping:output_type -> google.protobuf.Empty
+	28, // 42: dapr.proto.state.v1.Store.BulkDelete:output_type -> google.protobuf.Empty
+	9,  // 43: dapr.proto.state.v1.Store.BulkGet:output_type -> dapr.proto.state.v1.BulkGetResponse
+	28, // 44: dapr.proto.state.v1.Store.BulkSet:output_type -> google.protobuf.Empty
+	28, // 45: dapr.proto.state.v1.Store.Multi:output_type -> google.protobuf.Empty
+	15, // 46: dapr.proto.state.v1.Store.Query:output_type -> dapr.proto.state.v1.QueryResponse
+	36, // [36:47] is the sub-list for method output_type
+	25, // [25:36] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_state_v1_state_proto_init() }
@@ -1071,6 +1486,70 @@ func file_dapr_proto_state_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_state_v1_state_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionalStateOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_state_v1_state_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionalStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_state_v1_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_state_v1_state_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_state_v1_state_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_dapr_proto_state_v1_state_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*TransactionalStateOperation_Set)(nil),
+		(*TransactionalStateOperation_Delete)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1078,7 +1557,7 @@ func file_dapr_proto_state_v1_state_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_state_v1_state_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
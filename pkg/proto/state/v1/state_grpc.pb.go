@@ -28,6 +28,11 @@ type StoreClient interface {
 	BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BulkGet(ctx context.Context, in *BulkGetRequest, opts ...grpc.CallOption) (*BulkGetResponse, error)
 	BulkSet(ctx context.Context, in *BulkSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Multi executes the operations in a single transaction. It is only
+	// available when the store advertises the TRANSACTIONAL feature.
+	Multi(ctx context.Context, in *TransactionalStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Query runs a state query. Stores that don't support queries return UNIMPLEMENTED.
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 }
 
 type storeClient struct {
@@ -119,6 +124,24 @@ func (c *storeClient) BulkSet(ctx context.Context, in *BulkSetRequest, opts ...g
 	return out, nil
 }
 
+func (c *storeClient) Multi(ctx context.Context, in *TransactionalStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.state.v1.Store/Multi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.state.v1.Store/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreServer is the server API for Store service.
 // All implementations should embed UnimplementedStoreServer
 // for forward compatibility
@@ -132,6 +155,11 @@ type StoreServer interface {
 	BulkDelete(context.Context, *BulkDeleteRequest) (*emptypb.Empty, error)
 	BulkGet(context.Context, *BulkGetRequest) (*BulkGetResponse, error)
 	BulkSet(context.Context, *BulkSetRequest) (*emptypb.Empty, error)
+	// Multi executes the operations in a single transaction. It is only
+	// available when the store advertises the TRANSACTIONAL feature.
+	Multi(context.Context, *TransactionalStateRequest) (*emptypb.Empty, error)
+	// Query runs a state query. Stores that don't support queries return UNIMPLEMENTED.
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 }
 
 // UnimplementedStoreServer should be embedded to have forward compatible implementations.
@@ -165,6 +193,12 @@ func (UnimplementedStoreServer) BulkGet(context.Context, *BulkGetRequest) (*Bulk
 func (UnimplementedStoreServer) BulkSet(context.Context, *BulkSetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkSet not implemented")
 }
+func (UnimplementedStoreServer) Multi(context.Context, *TransactionalStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multi not implemented")
+}
+func (UnimplementedStoreServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
 
 // UnsafeStoreServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StoreServer will
@@ -339,6 +373,42 @@ func _Store_BulkSet_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_Multi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionalStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).Multi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.state.v1.Store/Multi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).Multi(ctx, req.(*TransactionalStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.state.v1.Store/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Store_ServiceDesc is the grpc.ServiceDesc for Store service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +452,14 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkSet",
 			Handler:    _Store_BulkSet_Handler,
 		},
+		{
+			MethodName: "Multi",
+			Handler:    _Store_Multi_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _Store_Query_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dapr/proto/state/v1/state.proto",
@@ -50,6 +50,9 @@ func FromFlags() (*DaprRuntime, error) {
 	profilePort := flag.String("profile-port", fmt.Sprintf("%v", DefaultProfilePort), "The port for the profile server")
 	appProtocol := flag.String("app-protocol", string(HTTPProtocol), "Protocol for the application: grpc or http")
 	componentsPath := flag.String("components-path", "", "Path for components directory. If empty, components will not be loaded. Self-hosted mode only")
+	pluginsPath := flag.String("plugins-path", "", "Path for plugins directory. Self-hosted mode only")
+	pluginsDiscoveryFile := flag.String("plugins-discovery-file", "", "Path for a static file locating the plugin containers. Kubernetes mode only")
+	pluginTimeoutSeconds := flag.Int("plugin-timeout-seconds", int(DefaultPluginTimeout/time.Second), "Timeout in seconds for each call to a plugin. 0 disables the timeout")
 	config := flag.String("config", "", "Path to config file, or name of a configuration object")
 	appID := flag.String("app-id", "", "A unique ID for Dapr. Used for Service Discovery and state")
 	controlPlaneAddress := flag.String("control-plane-address", "", "Address for a Dapr control plane")
@@ -198,6 +201,9 @@ func FromFlags() (*DaprRuntime, error) {
 	}
 	runtimeConfig := NewRuntimeConfig(*appID, placementAddresses, *controlPlaneAddress, *allowedOrigins, *config, *componentsPath,
 		appPrtcl, *mode, daprHTTP, daprInternalGRPC, daprAPIGRPC, daprAPIListenAddressList, publicPort, applicationPort, profPort, *enableProfiling, concurrency, *enableMTLS, *sentryAddress, *appSSL, maxRequestBodySize, *unixDomainSocket, readBufferSize, *daprHTTPStreamRequestBody, gracefulShutdownDuration)
+	runtimeConfig.Standalone.PluginsPath = *pluginsPath
+	runtimeConfig.Kubernetes.PluginsDiscoveryFile = *pluginsDiscoveryFile
+	runtimeConfig.PluginTimeout = time.Duration(*pluginTimeoutSeconds) * time.Second
 
 	// set environment variables
 	// TODO - consider adding host address to runtime config and/or caching result in utils package
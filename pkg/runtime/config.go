@@ -45,6 +45,8 @@ const (
 	DefaultAPIListenAddress = ""
 	// DefaultReadBufferSize is the default option for the maximum header size in KB for Dapr HTTP servers.
 	DefaultReadBufferSize = 4
+	// DefaultPluginTimeout is the default timeout for each call to a plugin.
+	DefaultPluginTimeout = 5 * time.Second
 )
 
 // Config holds the Dapr Runtime configuration.
@@ -75,6 +77,7 @@ type Config struct {
 	ReadBufferSize           int
 	StreamRequestBody        bool
 	GracefulShutdownDuration time.Duration
+	PluginTimeout            time.Duration
 }
 
 // NewRuntimeConfig returns a new runtime config.
@@ -113,5 +116,6 @@ func NewRuntimeConfig(
 		ReadBufferSize:           readBufferSize,
 		StreamRequestBody:        streamRequestBody,
 		GracefulShutdownDuration: gracefulShutdownDuration,
+		PluginTimeout:            DefaultPluginTimeout,
 	}
 }
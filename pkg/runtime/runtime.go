@@ -59,6 +59,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	"github.com/dapr/dapr/pkg/channel"
 	http_channel "github.com/dapr/dapr/pkg/channel/http"
 	"github.com/dapr/dapr/pkg/components"
@@ -86,6 +87,8 @@ import (
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/security"
 	"github.com/dapr/dapr/pkg/scopes"
+	bindings_sdk "github.com/dapr/dapr/pkg/sdk/bindings/v1"
+	pubsub_sdk "github.com/dapr/dapr/pkg/sdk/pubsub/v1"
 	"github.com/dapr/dapr/utils"
 )
 
@@ -162,6 +165,13 @@ type DaprRuntime struct {
 	pubSubRegistry         pubsub_loader.Registry
 	pubSubs                map[string]pubsub.PubSub
 	plugins                map[string]plugin.Plugin
+	pluginsLock            sync.RWMutex
+	loadedPlugins          map[string]plugins_v1alpha1.Plugin
+	// pluginInstances are the plugin instances by plugin resource name, guarded by pluginsLock
+	pluginInstances map[string]plugin.Plugin
+	// heldPluginInstances are the plugin instances serving the actor state store, the HTTP pipeline or the name resolution,
+	// which are built once at startup and keep the instance open when its plugin is updated, guarded by pluginsLock
+	heldPluginInstances    map[plugin.Plugin]bool
 	nameResolver           nr.Resolver
 	json                   jsoniter.API
 	httpMiddlewareRegistry http_middleware_loader.Registry
@@ -180,6 +190,10 @@ type DaprRuntime struct {
 	inputBindingRoutes     map[string]string
 	shutdownC              chan error
 	apiClosers             []io.Closer
+	// subscribing and readingBindings are set once the runtime delivers the subscriptions and the input bindings to the app, guarded by deliveryLock
+	deliveryLock    sync.Mutex
+	subscribing     bool
+	readingBindings bool
 
 	secretsConfiguration map[string]config.SecretsScope
 
@@ -188,6 +202,8 @@ type DaprRuntime struct {
 
 	pendingComponents          chan components_v1alpha1.Component
 	pendingComponentDependents map[string][]components_v1alpha1.Component
+	pendingPluginDependents    map[string][]plugins_v1alpha1.Plugin
+	pendingPlugins             chan plugins_v1alpha1.Plugin
 
 	proxy messaging.Proxy
 
@@ -236,6 +252,9 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, a
 		stateStores:            map[string]state.Store{},
 		pubSubs:                map[string]pubsub.PubSub{},
 		plugins:                map[string]plugin.Plugin{},
+		loadedPlugins:          map[string]plugins_v1alpha1.Plugin{},
+		pluginInstances:        map[string]plugin.Plugin{},
+		heldPluginInstances:    map[plugin.Plugin]bool{},
 		stateStoreRegistry:     state_loader.NewRegistry(),
 		bindingsRegistry:       bindings_loader.NewRegistry(),
 		pubSubRegistry:         pubsub_loader.NewRegistry(),
@@ -255,6 +274,8 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, a
 
 		pendingComponents:          make(chan components_v1alpha1.Component),
 		pendingComponentDependents: map[string][]components_v1alpha1.Component{},
+		pendingPluginDependents:    map[string][]plugins_v1alpha1.Plugin{},
+		pendingPlugins:             make(chan plugins_v1alpha1.Plugin),
 		shutdownC:                  make(chan error, 1),
 	}
 }
@@ -347,10 +368,13 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 		return errors.Wrap(err, "failed to setup tracing")
 	}
 	// Register and initialize name resolution for service discovery.
+	// Name resolution served by a plugin is initialized once the plugins are loaded.
 	a.nameResolutionRegistry.Register(opts.nameResolutions...)
-	err = a.initNameResolution()
-	if err != nil {
-		log.Warnf("failed to init name resolution: %s", err)
+	if !a.nameResolutionServedByPlugin() {
+		err = a.initNameResolution()
+		if err != nil {
+			log.Warnf("failed to init name resolution: %s", err)
+		}
 	}
 
 	a.pubSubRegistry.Register(opts.pubsubs...)
@@ -367,7 +391,15 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	if err != nil {
 		log.Warnf("failed to watch component updates: %s", err)
 	}
+	err = a.beginPluginsUpdates()
+	if err != nil {
+		log.Warnf("failed to watch plugin updates: %s", err)
+	}
 	a.appendBuiltinSecretStore()
+	err = a.loadPlugins()
+	if err != nil {
+		log.Warnf("failed to load plugins: %s", err)
+	}
 	err = a.loadComponents(opts)
 	if err != nil {
 		log.Warnf("failed to load components: %s", err)
@@ -375,6 +407,13 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 
 	a.flushOutstandingComponents()
 
+	if a.nameResolutionServedByPlugin() {
+		err = a.initNameResolution()
+		if err != nil {
+			log.Warnf("failed to init name resolution: %s", err)
+		}
+	}
+
 	pipeline, err := a.buildHTTPPipeline()
 	if err != nil {
 		log.Warnf("failed to build HTTP pipeline: %s", err)
@@ -490,8 +529,7 @@ func (a *DaprRuntime) buildHTTPPipeline() (http_middleware.Pipeline, error) {
 					middlewareSpec.Type,
 					middlewareSpec.Version)
 			}
-			handler, err := a.httpMiddlewareRegistry.Create(middlewareSpec.Type, middlewareSpec.Version,
-				middleware.Metadata{Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata)})
+			handler, err := a.createHTTPMiddleware(component, middlewareSpec.Type, middlewareSpec.Version)
 			if err != nil {
 				return http_middleware.Pipeline{}, err
 			}
@@ -502,7 +540,30 @@ func (a *DaprRuntime) buildHTTPPipeline() (http_middleware.Pipeline, error) {
 	return http_middleware.Pipeline{Handlers: handlers}, nil
 }
 
+// createHTTPMiddleware creates the http middleware of the component from its plugin, or from the registry otherwise.
+func (a *DaprRuntime) createHTTPMiddleware(c components_v1alpha1.Component, name, version string) (http_middleware.Middleware, error) {
+	metadata := middleware.Metadata{Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata)}
+	if p, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", c.Spec.Type, c.Spec.Version, c.Spec.Plugin)
+		a.holdPluginInstance(c.Name)
+		m, err := p.HTTPMiddleware()
+		if err != nil {
+			return nil, err
+		}
+		handler, err := m.GetHandler(metadata)
+		if err != nil {
+			return nil, err
+		}
+		return http_middleware.Middleware(handler), nil
+	}
+	return a.httpMiddlewareRegistry.Create(name, version, metadata)
+}
+
 func (a *DaprRuntime) initBinding(c components_v1alpha1.Component) error {
+	if _, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		return a.initPluginBinding(c)
+	}
+
 	if a.bindingsRegistry.HasOutputBinding(c.Spec.Type, c.Spec.Version) {
 		if err := a.initOutputBinding(c); err != nil {
 			log.Errorf("failed to init output bindings: %s", err)
@@ -519,6 +580,28 @@ func (a *DaprRuntime) initBinding(c components_v1alpha1.Component) error {
 	return nil
 }
 
+// initPluginBinding initializes the input and the output binding served by the plugin of the component. The plugin has to serve at least one of them.
+func (a *DaprRuntime) initPluginBinding(c components_v1alpha1.Component) error {
+	outputErr := a.initOutputBinding(c)
+	if outputErr != nil && !errors.Is(outputErr, plugin.ErrComponentNotImplemented) {
+		log.Errorf("failed to init output bindings: %s", outputErr)
+		return outputErr
+	}
+
+	inputErr := a.initInputBinding(c)
+	if inputErr != nil && !errors.Is(inputErr, plugin.ErrComponentNotImplemented) {
+		log.Errorf("failed to init input bindings: %s", inputErr)
+		return inputErr
+	}
+
+	if outputErr != nil && inputErr != nil {
+		log.Warnf("plugin of binding %s (%s/%s) serves neither input nor output bindings", c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version)
+		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+		return errors.Errorf("plugin of binding %s serves neither input nor output bindings", c.ObjectMeta.Name)
+	}
+	return nil
+}
+
 func (a *DaprRuntime) beginPubSub(name string, ps pubsub.PubSub) error {
 	var publishFunc func(ctx context.Context, msg *pubsubSubscribedMessage) error
 	switch a.runtimeConfig.ApplicationProtocol {
@@ -769,10 +852,82 @@ func (a *DaprRuntime) onComponentUpdated(component components_v1alpha1.Component
 	a.pendingComponents <- component
 }
 
+func (a *DaprRuntime) beginPluginsUpdates() error {
+	if a.runtimeConfig.Mode != modes.KubernetesMode {
+		return nil
+	}
+
+	go func() {
+		parseAndUpdate := func(pluginRaw []byte) {
+			var p plugins_v1alpha1.Plugin
+			if err := json.Unmarshal(pluginRaw, &p); err != nil {
+				log.Warnf("error deserializing plugin: %s", err)
+				return
+			}
+
+			log.Debugf("received plugin update. name: %s, type: %s", p.ObjectMeta.Name, p.Spec.Type)
+			a.pendingPlugins <- p
+		}
+
+		needList := false
+		for {
+			var stream operatorv1pb.Operator_PluginUpdateClient
+
+			// Retry on stream error.
+			backoff.Retry(func() error {
+				var err error
+				stream, err = a.operatorClient.PluginUpdate(context.Background(), &operatorv1pb.PluginUpdateRequest{
+					Namespace: a.namespace,
+				})
+				if err != nil {
+					log.Errorf("error from operator stream: %s", err)
+					return err
+				}
+				return nil
+			}, backoff.NewExponentialBackOff())
+
+			if needList {
+				// We should get all plugins again to avoid missing any updates during the failure time.
+				backoff.Retry(func() error {
+					resp, err := a.operatorClient.ListPlugins(context.Background(), &operatorv1pb.ListPluginsRequest{
+						Namespace: a.namespace,
+					})
+					if err != nil {
+						log.Errorf("error listing plugins: %s", err)
+						return err
+					}
+
+					plugins := resp.GetPlugins()
+					for i := 0; i < len(plugins); i++ {
+						go func(p []byte) {
+							parseAndUpdate(p)
+						}(plugins[i])
+					}
+
+					return nil
+				}, backoff.NewExponentialBackOff())
+			}
+
+			for {
+				p, err := stream.Recv()
+				if err != nil {
+					// Retry on stream error.
+					needList = true
+					log.Errorf("error from operator stream: %s", err)
+					break
+				}
+
+				parseAndUpdate(p.GetPlugin())
+			}
+		}
+	}()
+	return nil
+}
+
 func (a *DaprRuntime) sendBatchOutputBindingsParallel(to []string, data []byte) {
 	for _, dst := range to {
 		go func(name string) {
-			_, err := a.sendToOutputBinding(name, &bindings.InvokeRequest{
+			_, err := a.sendToOutputBinding(context.Background(), name, &bindings.InvokeRequest{
 				Data:      data,
 				Operation: bindings.CreateOperation,
 			})
@@ -785,7 +940,7 @@ func (a *DaprRuntime) sendBatchOutputBindingsParallel(to []string, data []byte)
 
 func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte) error {
 	for _, dst := range to {
-		_, err := a.sendToOutputBinding(dst, &bindings.InvokeRequest{
+		_, err := a.sendToOutputBinding(context.Background(), dst, &bindings.InvokeRequest{
 			Data:      data,
 			Operation: bindings.CreateOperation,
 		})
@@ -796,7 +951,7 @@ func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte
 	return nil
 }
 
-func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+func (a *DaprRuntime) sendToOutputBinding(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
 	if req.Operation == "" {
 		return nil, errors.New("operation field is missing from request")
 	}
@@ -805,7 +960,7 @@ func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.InvokeReque
 		ops := binding.Operations()
 		for _, o := range ops {
 			if o == req.Operation {
-				return binding.Invoke(req)
+				return bindings_sdk.WithContext(ctx, binding).Invoke(req)
 			}
 		}
 		supported := make([]string, 0, len(ops))
@@ -966,6 +1121,7 @@ func (a *DaprRuntime) readFromBinding(name string, binding bindings.InputBinding
 func (a *DaprRuntime) startHTTPServer(port int, publicPort *int, profilePort int, allowedOrigins string, pipeline http_middleware.Pipeline) error {
 	a.daprHTTPAPI = http.NewAPI(a.runtimeConfig.ID, a.appChannel, a.directMessaging, a.getComponents, a.stateStores, a.secretStores,
 		a.secretsConfiguration, a.getPublishAdapter(), a.actor, a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec, a.ShutdownWithWait)
+	a.daprHTTPAPI.SetPluginHealthFn(a.pluginHealth)
 	serverConf := http.NewServerConfig(a.runtimeConfig.ID, a.hostAddress, port, a.runtimeConfig.APIListenAddresses, publicPort, profilePort, allowedOrigins, a.runtimeConfig.EnableProfiling, a.runtimeConfig.MaxRequestBodySize, a.runtimeConfig.UnixDomainSocket, a.runtimeConfig.ReadBufferSize, a.runtimeConfig.StreamRequestBody)
 
 	server := http.NewServer(a.daprHTTPAPI, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, pipeline, a.globalConfig.Spec.APISpec)
@@ -1067,7 +1223,19 @@ func (a *DaprRuntime) isAppSubscribedToBinding(binding string) bool {
 }
 
 func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
-	binding, err := a.bindingsRegistry.CreateInputBinding(c.Spec.Type, c.Spec.Version)
+	var binding bindings.InputBinding
+	var err error
+
+	if p, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", c.Spec.Type, c.Spec.Version, c.Spec.Plugin)
+		binding, err = p.InputBinding()
+		if errors.Is(err, plugin.ErrComponentNotImplemented) {
+			return err
+		}
+	} else {
+		binding, err = a.bindingsRegistry.CreateInputBinding(c.Spec.Type, c.Spec.Version)
+	}
+
 	if err != nil {
 		log.Warnf("failed to create input binding %s (%s/%s): %s", c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version, err)
 		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
@@ -1096,7 +1264,19 @@ func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
 }
 
 func (a *DaprRuntime) initOutputBinding(c components_v1alpha1.Component) error {
-	binding, err := a.bindingsRegistry.CreateOutputBinding(c.Spec.Type, c.Spec.Version)
+	var binding bindings.OutputBinding
+	var err error
+
+	if p, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", c.Spec.Type, c.Spec.Version, c.Spec.Plugin)
+		binding, err = p.OutputBinding()
+		if errors.Is(err, plugin.ErrComponentNotImplemented) {
+			return err
+		}
+	} else {
+		binding, err = a.bindingsRegistry.CreateOutputBinding(c.Spec.Type, c.Spec.Version)
+	}
+
 	if err != nil {
 		log.Warnf("failed to create output binding %s (%s/%s): %s", c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version, err)
 		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
@@ -1121,7 +1301,16 @@ func (a *DaprRuntime) initOutputBinding(c components_v1alpha1.Component) error {
 }
 
 func (a *DaprRuntime) initConfiguration(s components_v1alpha1.Component) error {
-	store, err := a.configurationStoreRegistry.Create(s.Spec.Type, s.Spec.Version)
+	var store configuration.Store
+	var err error
+
+	if p, exists := a.plugins[s.Name]; s.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", s.Spec.Type, s.Spec.Version, s.Spec.Plugin)
+		store, err = p.ConfigurationStore()
+	} else {
+		store, err = a.configurationStoreRegistry.Create(s.Spec.Type, s.Spec.Version)
+	}
+
 	if err != nil {
 		log.Warnf("error creating configuration store %s (%s/%s): %s", s.ObjectMeta.Name, s.Spec.Type, s.Spec.Version, err)
 		diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "creation")
@@ -1321,7 +1510,16 @@ func (a *DaprRuntime) getTopicRoutes() (map[string]TopicRoute, error) {
 }
 
 func (a *DaprRuntime) initPubSub(c components_v1alpha1.Component) error {
-	pubSub, err := a.pubSubRegistry.Create(c.Spec.Type, c.Spec.Version)
+	var pubSub pubsub.PubSub
+	var err error
+
+	if p, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", c.Spec.Type, c.Spec.Version, c.Spec.Plugin)
+		pubSub, err = p.PubSub()
+	} else {
+		pubSub, err = a.pubSubRegistry.Create(c.Spec.Type, c.Spec.Version)
+	}
+
 	if err != nil {
 		log.Warnf("error creating pub sub %s (%s/%s): %s", &c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version, err)
 		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
@@ -1357,8 +1555,8 @@ func (a *DaprRuntime) initPubSub(c components_v1alpha1.Component) error {
 
 // Publish is an adapter method for the runtime to pre-validate publish requests
 // And then forward them to the Pub/Sub component.
-// This method is used by the HTTP and gRPC APIs.
-func (a *DaprRuntime) Publish(req *pubsub.PublishRequest) error {
+// This method is used by the HTTP and gRPC APIs, which bind the call of a plugin to the context of the request.
+func (a *DaprRuntime) Publish(ctx context.Context, req *pubsub.PublishRequest) error {
 	thepubsub := a.GetPubSub(req.PubsubName)
 	if thepubsub == nil {
 		return runtime_pubsub.NotFoundError{PubsubName: req.PubsubName}
@@ -1368,7 +1566,7 @@ func (a *DaprRuntime) Publish(req *pubsub.PublishRequest) error {
 		return runtime_pubsub.NotAllowedError{Topic: req.Topic, ID: a.runtimeConfig.ID}
 	}
 
-	return a.pubSubs[req.PubsubName].Publish(req)
+	return pubsub_sdk.WithContext(ctx, a.pubSubs[req.PubsubName]).Publish(req)
 }
 
 // GetPubSub is an adapter method to find a pubsub by name.
@@ -1429,7 +1627,7 @@ func (a *DaprRuntime) initNameResolution() error {
 		resolverVersion = components.FirstStableVersion
 	}
 
-	resolver, err = a.nameResolutionRegistry.Create(resolverName, resolverVersion)
+	resolver, err = a.createNameResolver(resolverName, resolverVersion)
 	resolverMetadata.Configuration = a.globalConfig.Spec.NameResolutionSpec.Configuration
 	resolverMetadata.Properties = map[string]string{
 		nr.DaprHTTPPort: strconv.Itoa(a.runtimeConfig.HTTPPort),
@@ -1459,6 +1657,28 @@ func (a *DaprRuntime) initNameResolution() error {
 	return nil
 }
 
+// nameResolutionServedByPlugin returns true if the name resolution component of the configuration is served by a plugin.
+func (a *DaprRuntime) nameResolutionServedByPlugin() bool {
+	return a.globalConfig.Spec.NameResolutionSpec.Plugin == plugin.TypeGRPC
+}
+
+// createNameResolver creates the name resolver from its plugin, or from the registry otherwise.
+func (a *DaprRuntime) createNameResolver(name, version string) (nr.Resolver, error) {
+	if !a.nameResolutionServedByPlugin() {
+		return a.nameResolutionRegistry.Create(name, version)
+	}
+
+	a.pluginsLock.RLock()
+	p, exists := a.plugins[name]
+	a.pluginsLock.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("name resolution %s is not served by any loaded plugin", name)
+	}
+	log.Debugf("name resolution %s %s plugin value : %s", name, version, plugin.TypeGRPC)
+	a.holdPluginInstance(name)
+	return p.NameResolver()
+}
+
 func (a *DaprRuntime) publishMessageHTTP(ctx context.Context, msg *pubsubSubscribedMessage) error {
 	cloudEvent := msg.cloudEvent
 
@@ -1662,7 +1882,10 @@ func (a *DaprRuntime) initActors() error {
 	actorConfig := actors.NewConfig(a.hostAddress, a.runtimeConfig.ID, a.runtimeConfig.PlacementAddresses, a.appConfig.Entities,
 		a.runtimeConfig.InternalGRPCPort, a.appConfig.ActorScanInterval, a.appConfig.ActorIdleTimeout, a.appConfig.DrainOngoingCallTimeout,
 		a.appConfig.DrainRebalancedActors, a.namespace, a.appConfig.Reentrancy, a.appConfig.RemindersStoragePartitions)
-	act := actors.NewActors(a.stateStores[a.actorStateStoreName], a.appChannel, a.grpc.GetGRPCConnection, actorConfig, a.runtimeConfig.CertChain, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.Features)
+	actorConfig.ReminderFailurePolicies = actors.NewReminderFailurePolicies(a.appConfig.ReminderFailurePolicies)
+	actorConfig.SetEntitiesConfig(a.appConfig.EntitiesConfig)
+	a.holdPluginInstance(a.actorStateStoreName)
+	act := actors.NewActors(a.stateStores[a.actorStateStoreName], a.appChannel, a.grpc.GetGRPCConnection, actorConfig, a.runtimeConfig.CertChain, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.Features, a)
 	err = act.Init()
 	a.actor = act
 	return err
@@ -1744,6 +1967,40 @@ func (a *DaprRuntime) loadComponents(opts *runtimeOpts) error {
 	return nil
 }
 
+func (a *DaprRuntime) getPluginLoader() (components.PluginLoader, error) {
+	var loader components.PluginLoader
+
+	switch a.runtimeConfig.Mode {
+	case modes.KubernetesMode:
+		loader = components.NewKubernetesComponents(a.runtimeConfig.Kubernetes, a.namespace, a.operatorClient)
+	case modes.StandaloneMode:
+		loader = components.NewStandaloneComponents(a.runtimeConfig.Standalone)
+	default:
+		return nil, errors.Errorf("plugins loader for mode %s not found", a.runtimeConfig.Mode)
+	}
+	return loader, nil
+}
+
+func (a *DaprRuntime) loadPlugins() error {
+	loader, err := a.getPluginLoader()
+	if err != nil {
+		return err
+	}
+
+	log.Info("loading plugins")
+	plugins, err := loader.LoadPlugins()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range plugins {
+		log.Debugf("found plugin. name: %s, type: %s", p.ObjectMeta.Name, p.Spec.Type)
+		a.pendingPlugins <- p
+	}
+
+	return nil
+}
+
 func (a *DaprRuntime) appendOrReplaceComponents(component components_v1alpha1.Component) {
 	a.componentsLock.Lock()
 	defer a.componentsLock.Unlock()
@@ -1772,20 +2029,37 @@ func (a *DaprRuntime) extractComponentCategory(component components_v1alpha1.Com
 }
 
 func (a *DaprRuntime) processComponents() {
-	for comp := range a.pendingComponents {
-		if comp.Name == "" {
-			continue
-		}
+	for {
+		select {
+		case comp, ok := <-a.pendingComponents:
+			if !ok {
+				return
+			}
+			if comp.Name == "" {
+				continue
+			}
 
-		err := a.processComponentAndDependents(comp)
-		if err != nil {
-			e := fmt.Sprintf("process component %s error: %s", comp.Name, err.Error())
-			if !comp.Spec.IgnoreErrors {
-				log.Warnf("process component error daprd process will exited, gracefully to stop")
-				a.Shutdown(a.runtimeConfig.GracefulShutdownDuration)
-				log.Fatalf(e)
+			err := a.processComponentAndDependents(comp)
+			if err != nil {
+				e := fmt.Sprintf("process component %s error: %s", comp.Name, err.Error())
+				if !comp.Spec.IgnoreErrors {
+					log.Warnf("process component error daprd process will exited, gracefully to stop")
+					a.Shutdown(a.runtimeConfig.GracefulShutdownDuration)
+					log.Fatalf(e)
+				}
+				log.Errorf(e)
+			}
+		case p := <-a.pendingPlugins:
+			err := a.processPluginAndDependents(p)
+			if err != nil {
+				e := fmt.Sprintf("process plugin %s error: %s", p.Name, err.Error())
+				if !p.Spec.IgnoreErrors {
+					log.Warnf("process plugin error daprd process will exited, gracefully to stop")
+					a.Shutdown(a.runtimeConfig.GracefulShutdownDuration)
+					log.Fatalf(e)
+				}
+				log.Errorf(e)
 			}
-			log.Errorf(e)
 		}
 	}
 }
@@ -1840,6 +2114,15 @@ func (a *DaprRuntime) processComponentAndDependents(comp components_v1alpha1.Com
 	diag.DefaultMonitoring.ComponentLoaded()
 
 	dependency := componentDependency(compCategory, comp.Name)
+	// plugins resolving their metadata secrets from this secret store may have been waiting for it to load
+	if deps, ok := a.pendingPluginDependents[dependency]; ok {
+		delete(a.pendingPluginDependents, dependency)
+		for _, dependent := range deps {
+			if err := a.processPluginAndDependents(dependent); err != nil {
+				return err
+			}
+		}
+	}
 	if deps, ok := a.pendingComponentDependents[dependency]; ok {
 		delete(a.pendingComponentDependents, dependency)
 		for _, dependent := range deps {
@@ -1852,6 +2135,287 @@ func (a *DaprRuntime) processComponentAndDependents(comp components_v1alpha1.Com
 	return nil
 }
 
+func (a *DaprRuntime) processPluginAndDependents(p plugins_v1alpha1.Plugin) error {
+	oldPlugin, exists := a.loadedPlugins[p.ObjectMeta.Name]
+	if exists && reflect.DeepEqual(oldPlugin.Spec, p.Spec) {
+		return nil
+	}
+
+	resolved, unreadySecretStore, err := a.processPluginSecrets(p)
+	if err != nil {
+		return err
+	}
+	if unreadySecretStore != "" {
+		dependency := componentDependency(secretStoreComponent, unreadySecretStore)
+		a.pendingPluginDependents[dependency] = append(a.pendingPluginDependents[dependency], p)
+		log.Debugf("delaying load of plugin name: %s, type: %s until secret store %s is loaded", p.ObjectMeta.Name, p.Spec.Type, unreadySecretStore)
+		return nil
+	}
+
+	log.Debugf("loading plugin. name: %s, type: %s", p.ObjectMeta.Name, p.Spec.Type)
+	oldInstance, err := a.initPlugin(resolved)
+	if err != nil {
+		if exists {
+			// the previous instance keeps serving the components of the plugin
+			log.Errorf("error updating plugin %s, the previous version keeps serving its components: %s", p.ObjectMeta.Name, err)
+			return nil
+		}
+		return err
+	}
+	a.loadedPlugins[p.ObjectMeta.Name] = p
+	log.Infof("plugin loaded. name: %s, type: %s", p.ObjectMeta.Name, p.Spec.Type)
+
+	// components served by an updated plugin are reinitialized against the new instance before the previous one is closed
+	if exists {
+		for _, c := range oldPlugin.Spec.Components {
+			if !pluginServesComponent(p, c.Name) {
+				log.Warnf("component %s is no longer served by plugin %s", c.Name, p.ObjectMeta.Name)
+			}
+		}
+		for _, comp := range a.getComponents() {
+			if comp.Spec.Plugin != plugin.TypeGRPC || !pluginServesComponent(p, comp.Name) {
+				continue
+			}
+			a.reinitPluginComponent(p, comp)
+		}
+	}
+	a.closePluginInstance(p.ObjectMeta.Name, oldInstance)
+
+	// components served by the plugin may have been waiting for it to load
+	for _, c := range p.Spec.Components {
+		dependency := componentDependency(pluginComponent, c.Name)
+		if deps, ok := a.pendingComponentDependents[dependency]; ok {
+			delete(a.pendingComponentDependents, dependency)
+			for _, dependent := range deps {
+				if err := a.processComponentAndDependents(dependent); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// reinitPluginComponent reinitializes a component against the updated instance of its plugin.
+// The subscriptions and the reading of the input binding move to the new instance once the runtime delivers them to the app,
+// and the clients of the previous instance are closed so it stops delivering them.
+func (a *DaprRuntime) reinitPluginComponent(p plugins_v1alpha1.Plugin, comp components_v1alpha1.Component) {
+	a.deliveryLock.Lock()
+	defer a.deliveryLock.Unlock()
+
+	oldPubSub := a.pubSubs[comp.Name]
+	oldInputBinding := a.inputBindings[comp.Name]
+	if err := a.processComponentAndDependents(comp); err != nil {
+		log.Errorf("error reinitializing component %s against the updated plugin %s: %s", comp.Name, p.ObjectMeta.Name, err)
+		return
+	}
+
+	if ps, ok := a.pubSubs[comp.Name]; ok && oldPubSub != nil && ps != oldPubSub {
+		if a.subscribing {
+			if err := a.beginPubSub(comp.Name, ps); err != nil {
+				log.Errorf("error occurred while beginning pubsub %s: %s", comp.Name, err)
+			}
+		}
+		if err := oldPubSub.Close(); err != nil {
+			log.Warnf("error closing the previous pubsub %s of plugin %s: %s", comp.Name, p.ObjectMeta.Name, err)
+		}
+	}
+	if binding, ok := a.inputBindings[comp.Name]; ok && oldInputBinding != nil && binding != oldInputBinding {
+		if a.readingBindings && a.isAppSubscribedToBinding(comp.Name) {
+			go func() {
+				if err := a.readFromBinding(comp.Name, binding); err != nil {
+					log.Errorf("error reading from input binding %s: %s", comp.Name, err)
+				}
+			}()
+		}
+		if closer, ok := oldInputBinding.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				log.Warnf("error closing the previous input binding %s of plugin %s: %s", comp.Name, p.ObjectMeta.Name, err)
+			}
+		}
+	}
+}
+
+// closePluginInstance closes the replaced instance of an updated plugin, unless the actor state store, the HTTP pipeline
+// or the name resolution still use it, in which case it stays open until shutdown.
+func (a *DaprRuntime) closePluginInstance(name string, instance plugin.Plugin) {
+	if instance == nil {
+		return
+	}
+	a.pluginsLock.RLock()
+	held := a.heldPluginInstances[instance]
+	a.pluginsLock.RUnlock()
+	if held {
+		log.Infof("the previous version of plugin %s stays open, it serves components built at startup", name)
+		return
+	}
+	if closer, ok := instance.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Warnf("error closing the previous version of plugin %s: %s", name, err)
+		}
+	}
+}
+
+// holdPluginInstance marks the plugin instance serving the component as held by a part of the runtime built once at startup.
+func (a *DaprRuntime) holdPluginInstance(componentName string) {
+	a.pluginsLock.Lock()
+	defer a.pluginsLock.Unlock()
+	if instance, ok := a.plugins[componentName]; ok {
+		a.heldPluginInstances[instance] = true
+	}
+}
+
+// processPluginSecrets resolves the secretKeyRef metadata of the plugin from its auth secret store.
+// It returns the name of the secret store when it isn't loaded yet, in which case the plugin is left unresolved.
+// A plugin can't resolve its metadata secrets from a secret store it serves, since the store is only loaded once the plugin is.
+func (a *DaprRuntime) processPluginSecrets(p plugins_v1alpha1.Plugin) (plugins_v1alpha1.Plugin, string, error) {
+	p = *p.DeepCopy()
+	secretStoreName := a.secretStoreOrDefault(p.SecretStore)
+	if hasSecretKeyRefs(p.Spec.Metadata) && pluginServesComponent(p, secretStoreName) {
+		return p, "", errors.Errorf("plugin %s resolves its metadata secrets from secret store %s, which it serves itself", p.ObjectMeta.Name, secretStoreName)
+	}
+	return p, a.processMetadataSecrets("plugin", p.ObjectMeta.Name, p.ObjectMeta.Namespace, secretStoreName, p.Spec.Metadata), nil
+}
+
+func hasSecretKeyRefs(metadata []components_v1alpha1.MetadataItem) bool {
+	for _, m := range metadata {
+		if m.SecretKeyRef.Name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func pluginServesComponent(p plugins_v1alpha1.Plugin, name string) bool {
+	for _, c := range p.Spec.Components {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// initPlugin creates and initializes the plugin, then maps the components it serves to the new instance.
+// The components that are no longer served are unmapped. The replaced instance is returned so it can be closed once its components moved to the new one.
+func (a *DaprRuntime) initPlugin(p plugins_v1alpha1.Plugin) (plugin.Plugin, error) {
+	cfg := a.pluginConfig(p)
+	instance, err := a.pluginRegistry.Create(p.Spec.Type, p.Spec.Version, cfg)
+	if err != nil {
+		log.Warnf("error creating plugin %s (%s/%s): %s", p.ObjectMeta.Name, cfg.Name, cfg.Version, err)
+		diag.DefaultMonitoring.ComponentInitFailed(p.Spec.Type, "creation")
+		return nil, err
+	}
+
+	timeout, err := time.ParseDuration(p.Spec.InitTimeout)
+	if err != nil {
+		timeout = defaultComponentInitTimeout
+	}
+
+	ch := make(chan error, 1)
+	metadata := configuration.Metadata{
+		Properties: a.convertPluginMetadataItemsToProperties(p.Spec.Metadata),
+	}
+	go func() {
+		ch <- instance.Init(metadata)
+	}()
+
+	select {
+	case err = <-ch:
+	case <-time.After(timeout):
+		err = fmt.Errorf("init timeout for plugin %s exceeded after %s", p.ObjectMeta.Name, timeout.String())
+		// the instance is closed once its initialization returns, so a late plugin process does not keep running
+		if closer, ok := instance.(io.Closer); ok {
+			go func() {
+				<-ch
+				closer.Close()
+			}()
+		}
+		log.Warnf("error initializing plugin %s (%s/%s): %s", p.ObjectMeta.Name, cfg.Name, cfg.Version, err)
+		diag.DefaultMonitoring.ComponentInitFailed(p.Spec.Type, "init")
+		return nil, err
+	}
+	if err != nil {
+		log.Warnf("error initializing plugin %s (%s/%s): %s", p.ObjectMeta.Name, cfg.Name, cfg.Version, err)
+		diag.DefaultMonitoring.ComponentInitFailed(p.Spec.Type, "init")
+		if closer, ok := instance.(io.Closer); ok {
+			closer.Close()
+		}
+		return nil, err
+	}
+
+	a.pluginsLock.Lock()
+	oldInstance := a.pluginInstances[p.ObjectMeta.Name]
+	if oldPlugin, ok := a.loadedPlugins[p.ObjectMeta.Name]; ok {
+		for _, c := range oldPlugin.Spec.Components {
+			delete(a.plugins, c.Name)
+		}
+	}
+	for _, c := range p.Spec.Components {
+		a.plugins[c.Name] = instance
+	}
+	a.pluginInstances[p.ObjectMeta.Name] = instance
+	a.pluginsLock.Unlock()
+	diag.DefaultMonitoring.ComponentInitialized(p.Spec.Type)
+	return oldInstance, nil
+}
+
+// pluginHealth returns an error when the process of a plugin serving a component is down.
+func (a *DaprRuntime) pluginHealth() error {
+	a.pluginsLock.RLock()
+	defer a.pluginsLock.RUnlock()
+	for name, p := range a.plugins {
+		if checker, ok := p.(plugin.HealthChecker); ok {
+			if err := checker.Health(); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// pluginConfig creates the plugin configuration for the given plugin resource.
+// The run name and version locate the plugin binary, falling back to the resource name and container tag.
+func (a *DaprRuntime) pluginConfig(p plugins_v1alpha1.Plugin) plugin.Config {
+	cfg := plugin.Config{
+		Name:          p.ObjectMeta.Name,
+		Type:          p.Spec.Type,
+		Timeout:       a.runtimeConfig.PluginTimeout,
+		Authenticator: a.authenticator,
+		Namespace:     a.namespace,
+		Standalone:    a.runtimeConfig.Standalone,
+		Kubernetes:    a.runtimeConfig.Kubernetes,
+	}
+	if p.Spec.Run != nil {
+		if p.Spec.Run.Name != "" {
+			cfg.Name = p.Spec.Run.Name
+		}
+		cfg.Version = p.Spec.Run.Version
+	} else if p.Spec.Container != nil {
+		cfg.Version = p.Spec.Container.Tag
+	}
+	if p.Spec.Remote != nil {
+		cfg.Address = p.Spec.Remote.Address
+	}
+	if p.Spec.TLS != nil {
+		cfg.TLS = plugin.TLSConfig{
+			Mode:       p.Spec.TLS.Mode,
+			CACert:     p.Spec.TLS.CACert,
+			ServerName: p.Spec.TLS.ServerName,
+			AppID:      p.Spec.TLS.AppID,
+		}
+	}
+	if cfg.TLS.AppID == "" {
+		cfg.TLS.AppID = p.ObjectMeta.Name
+	}
+	for _, c := range p.Spec.Components {
+		if !utils.StringSliceContains(c.ComponentType, cfg.ComponentTypes) {
+			cfg.ComponentTypes = append(cfg.ComponentTypes, c.ComponentType)
+		}
+	}
+	return cfg
+}
+
 func (a *DaprRuntime) doProcessOneComponent(category ComponentCategory, comp components_v1alpha1.Component) error {
 	switch category {
 	case bindingsComponent:
@@ -1956,7 +2520,10 @@ func (a *DaprRuntime) shutdownComponents() error {
 			log.Warn(err)
 		}
 	}
-	for name, plugin := range a.plugins {
+	a.pluginsLock.Lock()
+	defer a.pluginsLock.Unlock()
+	for name, plugin := range a.pluginInstances {
+		delete(a.heldPluginInstances, plugin)
 		if closer, ok := plugin.(io.Closer); ok {
 			if err := closer.Close(); err != nil {
 				err = fmt.Errorf("error closing plugin %s: %w", name, err)
@@ -1965,6 +2532,16 @@ func (a *DaprRuntime) shutdownComponents() error {
 			}
 		}
 	}
+	// previous versions of updated plugins held until shutdown
+	for plugin := range a.heldPluginInstances {
+		if closer, ok := plugin.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				err = fmt.Errorf("error closing a previous plugin version: %w", err)
+				merr = multierror.Append(merr, err)
+				log.Warn(err)
+			}
+		}
+	}
 	return merr
 }
 
@@ -2005,18 +2582,24 @@ func (a *DaprRuntime) WaitUntilShutdown() error {
 }
 
 func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Component) (components_v1alpha1.Component, string) {
+	secretStoreName := a.processMetadataSecrets("component", component.Name, component.Namespace, a.authSecretStoreOrDefault(component), component.Spec.Metadata)
+	return component, secretStoreName
+}
+
+// processMetadataSecrets resolves the secretKeyRef metadata items of a component or a plugin in place from the auth secret store.
+// It returns the name of the secret store when it isn't loaded yet, in which case the items are left unresolved.
+func (a *DaprRuntime) processMetadataSecrets(kind, name, namespace, secretStoreName string, metadata []components_v1alpha1.MetadataItem) string {
 	cache := map[string]secretstores.GetSecretResponse{}
 
-	for i, m := range component.Spec.Metadata {
+	for i, m := range metadata {
 		if m.SecretKeyRef.Name == "" {
 			continue
 		}
 
-		secretStoreName := a.authSecretStoreOrDefault(component)
 		secretStore := a.getSecretStore(secretStoreName)
 		if secretStore == nil {
-			log.Warnf("component %s references a secret store that isn't loaded: %s", component.Name, secretStoreName)
-			return component, secretStoreName
+			log.Warnf("%s %s references a secret store that isn't loaded: %s", kind, name, secretStoreName)
+			return secretStoreName
 		}
 
 		// If running in Kubernetes, do not fetch secrets from the Kubernetes secret store as they will be populated by the operator.
@@ -2043,7 +2626,7 @@ func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Comp
 				},
 			}
 
-			component.Spec.Metadata[i] = m
+			metadata[i] = m
 			continue
 		}
 
@@ -2052,7 +2635,7 @@ func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Comp
 			r, err := secretStore.GetSecret(secretstores.GetSecretRequest{
 				Name: m.SecretKeyRef.Name,
 				Metadata: map[string]string{
-					"namespace": component.ObjectMeta.Namespace,
+					"namespace": namespace,
 				},
 			})
 			if err != nil {
@@ -2070,7 +2653,7 @@ func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Comp
 
 		val, ok := resp.Data[secretKeyName]
 		if ok {
-			component.Spec.Metadata[i].Value = components_v1alpha1.DynamicValue{
+			metadata[i].Value = components_v1alpha1.DynamicValue{
 				JSON: v1.JSON{
 					Raw: []byte(val),
 				},
@@ -2079,17 +2662,21 @@ func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Comp
 
 		cache[m.SecretKeyRef.Name] = resp
 	}
-	return component, ""
+	return ""
 }
 
 func (a *DaprRuntime) authSecretStoreOrDefault(comp components_v1alpha1.Component) string {
-	if comp.SecretStore == "" {
+	return a.secretStoreOrDefault(comp.SecretStore)
+}
+
+func (a *DaprRuntime) secretStoreOrDefault(secretStore string) string {
+	if secretStore == "" {
 		switch a.runtimeConfig.Mode {
 		case modes.KubernetesMode:
 			return "kubernetes"
 		}
 	}
-	return comp.SecretStore
+	return secretStore
 }
 
 func (a *DaprRuntime) getSecretStore(storeName string) secretstores.SecretStore {
@@ -2184,7 +2771,16 @@ func (a *DaprRuntime) builtinSecretStore() []components_v1alpha1.Component {
 }
 
 func (a *DaprRuntime) initSecretStore(c components_v1alpha1.Component) error {
-	secretStore, err := a.secretStoresRegistry.Create(c.Spec.Type, c.Spec.Version)
+	var secretStore secretstores.SecretStore
+	var err error
+
+	if p, exists := a.plugins[c.Name]; c.Spec.Plugin == plugin.TypeGRPC && exists {
+		log.Debugf("component %s %s plugin value : %s", c.Spec.Type, c.Spec.Version, c.Spec.Plugin)
+		secretStore, err = p.SecretStore()
+	} else {
+		secretStore, err = a.secretStoresRegistry.Create(c.Spec.Type, c.Spec.Version)
+	}
+
 	if err != nil {
 		log.Warnf("failed to create secret store %s/%s: %s", c.Spec.Type, c.Spec.Version, err)
 		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
@@ -2217,6 +2813,14 @@ func (a *DaprRuntime) convertMetadataItemsToProperties(items []components_v1alph
 	return properties
 }
 
+func (a *DaprRuntime) convertPluginMetadataItemsToProperties(items []plugins_v1alpha1.MetadataItem) map[string]string {
+	properties := map[string]string{}
+	for _, m := range items {
+		properties[m.Name] = m.Value.String()
+	}
+	return properties
+}
+
 func (a *DaprRuntime) getComponent(componentType string, name string) (components_v1alpha1.Component, bool) {
 	a.componentsLock.RLock()
 	defer a.componentsLock.RUnlock()
@@ -2266,6 +2870,9 @@ func componentDependency(compCategory ComponentCategory, name string) string {
 }
 
 func (a *DaprRuntime) startSubscribing() {
+	a.deliveryLock.Lock()
+	defer a.deliveryLock.Unlock()
+	a.subscribing = true
 	for name, pubsub := range a.pubSubs {
 		if err := a.beginPubSub(name, pubsub); err != nil {
 			log.Errorf("error occurred while beginning pubsub %s: %s", name, err)
@@ -2277,6 +2884,9 @@ func (a *DaprRuntime) startReadingFromBindings() error {
 	if a.appChannel == nil {
 		return errors.New("app channel not initialized")
 	}
+	a.deliveryLock.Lock()
+	defer a.deliveryLock.Unlock()
+	a.readingBindings = true
 	for name, binding := range a.inputBindings {
 		go func(name string, binding bindings.InputBinding) {
 			if !a.isAppSubscribedToBinding(name) {
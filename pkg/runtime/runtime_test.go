@@ -16,6 +16,7 @@ package runtime
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -41,6 +42,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -49,6 +51,7 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/configuration"
 	"github.com/dapr/components-contrib/contenttype"
 	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
@@ -56,10 +59,12 @@ import (
 	"github.com/dapr/components-contrib/state"
 
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	plugins_v1alpha1 "github.com/dapr/dapr/pkg/apis/plugins/v1alpha1"
 	subscriptionsapi "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
+	plugin_loader "github.com/dapr/dapr/pkg/components/plugin"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
@@ -741,6 +746,46 @@ func TestInitNameResolution(t *testing.T) {
 		// assert
 		assert.NoError(t, err, "expected no error")
 	})
+
+	t.Run("test init nameresolution served by a plugin", func(t *testing.T) {
+		// given
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+
+		// target resolver
+		rt.globalConfig.Spec.NameResolutionSpec.Component = "pluginResolver"
+		rt.globalConfig.Spec.NameResolutionSpec.Plugin = plugin.TypeGRPC
+
+		// the registered resolver of the same name is not used
+		registered := initMockResolverForRuntime(rt, "pluginResolver", nil)
+		pluginResolver := new(daprt.MockResolver)
+		pluginResolver.On("Init", mock.Anything).Return(nil)
+		rt.plugins["pluginResolver"] = &daprt.MockPlugin{
+			InternalNameResolver: pluginResolver,
+		}
+
+		// act
+		err := rt.initNameResolution()
+
+		// assert
+		assert.NoError(t, err, "expected no error")
+		assert.Same(t, pluginResolver, rt.nameResolver)
+		registered.AssertNotCalled(t, "Init", mock.Anything)
+	})
+
+	t.Run("error on nameresolution plugin not loaded", func(t *testing.T) {
+		// given
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+
+		// target resolver
+		rt.globalConfig.Spec.NameResolutionSpec.Component = "pluginResolver"
+		rt.globalConfig.Spec.NameResolutionSpec.Plugin = plugin.TypeGRPC
+
+		// act
+		err := rt.initNameResolution()
+
+		// assert
+		assert.EqualError(t, err, "name resolution pluginResolver is not served by any loaded plugin")
+	})
 }
 
 func TestSetupTracing(t *testing.T) {
@@ -1029,6 +1074,22 @@ func TestInitPubSub(t *testing.T) {
 		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
 	})
 
+	t.Run("init pubsub, plugin", func(t *testing.T) {
+		initMockPubSubForRuntime(rt)
+		internalPubSub := plugin.NewMemoryPubSub()
+		rt.plugins[TestPubsubName] = &daprt.MockPlugin{
+			InternalPubSub: internalPubSub,
+		}
+		defer delete(rt.plugins, TestPubsubName)
+		comp := pubsubComponents[0]
+		comp.Spec.Plugin = plugin.TypeGRPC
+
+		err := rt.initPubSub(comp)
+		assert.NoError(t, err)
+		// validate the plugin instance was used
+		assert.Same(t, internalPubSub, rt.pubSubs[TestPubsubName])
+	})
+
 	t.Run("subscribe to topic with custom route", func(t *testing.T) {
 		mockPubSub, _ := initMockPubSubForRuntime(rt)
 
@@ -1337,7 +1398,7 @@ func TestInitPubSub(t *testing.T) {
 		rt.pubSubs[TestPubsubName] = &mockPublishPubSub{}
 		md := make(map[string]string, 2)
 		md["key"] = "v3"
-		err := rt.Publish(&pubsub.PublishRequest{
+		err := rt.Publish(context.Background(), &pubsub.PublishRequest{
 			PubsubName: TestPubsubName,
 			Topic:      "topic0",
 			Metadata:   md,
@@ -1346,7 +1407,7 @@ func TestInitPubSub(t *testing.T) {
 		assert.Nil(t, err)
 
 		rt.pubSubs[TestSecondPubsubName] = &mockPublishPubSub{}
-		err = rt.Publish(&pubsub.PublishRequest{
+		err = rt.Publish(context.Background(), &pubsub.PublishRequest{
 			PubsubName: TestSecondPubsubName,
 			Topic:      "topic1",
 		})
@@ -1377,14 +1438,14 @@ func TestInitPubSub(t *testing.T) {
 		}
 
 		rt.pubSubs[TestPubsubName] = &mockPublishPubSub{}
-		err := rt.Publish(&pubsub.PublishRequest{
+		err := rt.Publish(context.Background(), &pubsub.PublishRequest{
 			PubsubName: TestPubsubName,
 			Topic:      "topic5",
 		})
 		assert.NotNil(t, err)
 
 		rt.pubSubs[TestPubsubName] = &mockPublishPubSub{}
-		err = rt.Publish(&pubsub.PublishRequest{
+		err = rt.Publish(context.Background(), &pubsub.PublishRequest{
 			PubsubName: TestSecondPubsubName,
 			Topic:      "topic5",
 		})
@@ -1907,6 +1968,661 @@ func TestFlushOutstandingComponent(t *testing.T) {
 	})
 }
 
+func TestProcessPluginAndDependents(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	internalStore := plugin.NewMemoryStore()
+	var pluginCfg plugin.Config
+	var initErr error
+	instances := []*daprt.MockPlugin{}
+	rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+		pluginCfg = cfg
+		instance := &daprt.MockPlugin{
+			InternalStore: internalStore,
+			InitErr:       initErr,
+		}
+		instances = append(instances, instance)
+		return instance, nil
+	}))
+
+	go rt.processComponents()
+
+	// the component is delayed until the plugin serving it is loaded
+	rt.pendingComponents <- components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pluginStore",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "state.memory",
+			Version: "v1",
+			Plugin:  plugin.TypeGRPC,
+		},
+	}
+	rt.flushOutstandingComponents()
+	assert.NotContains(t, rt.stateStores, "pluginStore")
+
+	p := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "memory",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type: plugin.TypeGRPC,
+			Run: &plugins_v1alpha1.Run{
+				Name:    "gomemory",
+				Version: "v0.0.1",
+			},
+			Components: []plugins_v1alpha1.Component{
+				{
+					Name:          "pluginStore",
+					ComponentType: "state",
+				},
+			},
+		},
+	}
+	rt.pendingPlugins <- p
+	rt.flushOutstandingComponents()
+
+	assert.Len(t, instances, 1)
+	assert.Equal(t, "gomemory", pluginCfg.Name)
+	assert.Equal(t, "v0.0.1", pluginCfg.Version)
+	assert.Equal(t, plugin.TypeGRPC, pluginCfg.Type)
+	assert.Equal(t, []string{"state"}, pluginCfg.ComponentTypes)
+	assert.Contains(t, rt.plugins, "pluginStore")
+	assert.Same(t, internalStore, rt.stateStores["pluginStore"])
+
+	t.Run("unchanged plugin is not reloaded", func(t *testing.T) {
+		rt.pendingPlugins <- p
+		rt.flushOutstandingComponents()
+		assert.Len(t, instances, 1)
+	})
+
+	t.Run("updated plugin is reloaded", func(t *testing.T) {
+		updated := *p.DeepCopy()
+		updated.Spec.Run.Version = "v0.0.2"
+		rt.pendingPlugins <- updated
+		rt.flushOutstandingComponents()
+		assert.Len(t, instances, 2)
+		assert.Equal(t, "v0.0.2", pluginCfg.Version)
+		assert.Same(t, internalStore, rt.stateStores["pluginStore"])
+		assert.Same(t, instances[1], rt.plugins["pluginStore"])
+		// the previous instance is closed once the components moved to the new one
+		assert.Equal(t, 1, instances[0].Closed)
+		assert.Equal(t, 0, instances[1].Closed)
+		p = updated
+	})
+
+	t.Run("failed update keeps the previous instance", func(t *testing.T) {
+		initErr = errors.New("init error")
+		defer func() { initErr = nil }()
+		updated := *p.DeepCopy()
+		updated.Spec.Run.Version = "v0.0.3"
+		rt.pendingPlugins <- updated
+		rt.flushOutstandingComponents()
+		assert.Len(t, instances, 3)
+		assert.Same(t, instances[1], rt.plugins["pluginStore"])
+		assert.Equal(t, 0, instances[1].Closed)
+		// the failed instance is closed
+		assert.Equal(t, 1, instances[2].Closed)
+		assert.Equal(t, "v0.0.2", rt.loadedPlugins["memory"].Spec.Run.Version)
+	})
+
+	t.Run("components dropped by an update are unmapped", func(t *testing.T) {
+		updated := *p.DeepCopy()
+		updated.Spec.Run.Version = "v0.0.4"
+		updated.Spec.Components = []plugins_v1alpha1.Component{
+			{
+				Name:          "otherStore",
+				ComponentType: "state",
+			},
+		}
+		rt.pendingPlugins <- updated
+		rt.flushOutstandingComponents()
+		assert.Len(t, instances, 4)
+		assert.NotContains(t, rt.plugins, "pluginStore")
+		assert.Same(t, instances[3], rt.plugins["otherStore"])
+		assert.Equal(t, 1, instances[1].Closed)
+		p = updated
+	})
+
+	t.Run("remote address plugin", func(t *testing.T) {
+		rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeRemoteAddress, "", func(cfg plugin.Config) (plugin.Plugin, error) {
+			pluginCfg = cfg
+			return &daprt.MockPlugin{}, nil
+		}))
+		remote := plugins_v1alpha1.Plugin{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name: "remote",
+			},
+			Spec: plugins_v1alpha1.PluginSpec{
+				Type: plugin.TypeRemoteAddress,
+				Remote: &plugins_v1alpha1.Remote{
+					Address: "10.0.0.1:50001",
+				},
+			},
+		}
+		err := rt.processPluginAndDependents(remote)
+		assert.NoError(t, err)
+		assert.Equal(t, "10.0.0.1:50001", pluginCfg.Address)
+		assert.Equal(t, plugin.TypeRemoteAddress, pluginCfg.Type)
+	})
+
+	t.Run("unknown plugin type", func(t *testing.T) {
+		unknown := *p.DeepCopy()
+		unknown.ObjectMeta.Name = "unknown"
+		unknown.Spec.Type = "wasm"
+		err := rt.processPluginAndDependents(unknown)
+		assert.EqualError(t, err, "couldn't find plugin type wasm/")
+	})
+
+	t.Run("plugin failing with ignored errors", func(t *testing.T) {
+		ignored := *p.DeepCopy()
+		ignored.ObjectMeta.Name = "ignored"
+		ignored.Spec.Type = "wasm"
+		ignored.Spec.IgnoreErrors = true
+		ignored.Spec.Components = []plugins_v1alpha1.Component{{Name: "ignoredStore", ComponentType: "state"}}
+		rt.pendingPlugins <- ignored
+		rt.flushOutstandingComponents()
+		assert.NotContains(t, rt.plugins, "ignoredStore")
+		assert.NotContains(t, rt.loadedPlugins, "ignored")
+	})
+}
+
+// hangingPlugin blocks its initialization until it is released
+type hangingPlugin struct {
+	*daprt.MockPlugin
+	release chan struct{}
+	closed  chan struct{}
+}
+
+func (p *hangingPlugin) Init(metadata configuration.Metadata) error {
+	<-p.release
+	return nil
+}
+
+func (p *hangingPlugin) Close() error {
+	close(p.closed)
+	return nil
+}
+
+func TestPluginInitTimeout(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	var instance *hangingPlugin
+	rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+		instance = &hangingPlugin{
+			MockPlugin: &daprt.MockPlugin{InternalStore: plugin.NewMemoryStore()},
+			release:    make(chan struct{}),
+			closed:     make(chan struct{}),
+		}
+		return instance, nil
+	}))
+
+	p := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "hanging",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type:        plugin.TypeGRPC,
+			Run:         &plugins_v1alpha1.Run{Name: "hanging"},
+			InitTimeout: "10ms",
+			Components: []plugins_v1alpha1.Component{
+				{
+					Name:          "hangingStore",
+					ComponentType: "state",
+				},
+			},
+		},
+	}
+
+	t.Run("expired init is an error", func(t *testing.T) {
+		err := rt.processPluginAndDependents(p)
+		assert.EqualError(t, err, "init timeout for plugin hanging exceeded after 10ms")
+		assert.NotContains(t, rt.plugins, "hangingStore")
+		assert.NotContains(t, rt.loadedPlugins, "hanging")
+
+		// the instance is closed once its initialization returns
+		close(instance.release)
+		select {
+		case <-instance.closed:
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "plugin was not closed")
+		}
+	})
+
+	t.Run("expired init with ignored errors", func(t *testing.T) {
+		go rt.processComponents()
+
+		ignored := *p.DeepCopy()
+		ignored.Spec.IgnoreErrors = true
+		rt.pendingPlugins <- ignored
+		rt.flushOutstandingComponents()
+		assert.NotContains(t, rt.plugins, "hangingStore")
+		assert.NotContains(t, rt.loadedPlugins, "hanging")
+		close(instance.release)
+	})
+}
+
+func TestUpdatedPluginMovesSubscriptions(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+	rt.topicRoutes = map[string]TopicRoute{
+		"pluginPubSub": {routes: map[string]Route{"topic1": {}}},
+	}
+
+	instances := []*daprt.MockPlugin{}
+	pubSubs := []*recordingPubSub{}
+	rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+		ps := &recordingPubSub{}
+		pubSubs = append(pubSubs, ps)
+		instance := &daprt.MockPlugin{
+			InternalPubSub: ps,
+		}
+		instances = append(instances, instance)
+		return instance, nil
+	}))
+
+	go rt.processComponents()
+
+	p := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pubsub",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type: plugin.TypeGRPC,
+			Run: &plugins_v1alpha1.Run{
+				Name:    "gopubsub",
+				Version: "v0.0.1",
+			},
+			Components: []plugins_v1alpha1.Component{
+				{
+					Name:          "pluginPubSub",
+					ComponentType: "pubsub",
+				},
+			},
+		},
+	}
+	rt.pendingPlugins <- p
+	rt.pendingComponents <- components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pluginPubSub",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "pubsub.memory",
+			Version: "v1",
+			Plugin:  plugin.TypeGRPC,
+		},
+	}
+	rt.flushOutstandingComponents()
+	rt.startSubscribing()
+	require.Len(t, pubSubs, 1)
+	assert.Equal(t, []string{"topic1"}, pubSubs[0].topics)
+
+	t.Run("subscriptions move to the updated plugin", func(t *testing.T) {
+		updated := *p.DeepCopy()
+		updated.Spec.Run.Version = "v0.0.2"
+		rt.pendingPlugins <- updated
+		rt.flushOutstandingComponents()
+		require.Len(t, pubSubs, 2)
+		assert.Same(t, pubSubs[1], rt.pubSubs["pluginPubSub"])
+		assert.Equal(t, []string{"topic1"}, pubSubs[1].topics)
+		// the previous pubsub is closed, which ends its subscriptions
+		assert.Equal(t, 1, pubSubs[0].closed)
+		assert.Equal(t, 1, instances[0].Closed)
+		p = updated
+	})
+
+	t.Run("instance held by the runtime stays open", func(t *testing.T) {
+		rt.holdPluginInstance("pluginPubSub")
+		updated := *p.DeepCopy()
+		updated.Spec.Run.Version = "v0.0.3"
+		rt.pendingPlugins <- updated
+		rt.flushOutstandingComponents()
+		require.Len(t, pubSubs, 3)
+		assert.Equal(t, 1, pubSubs[1].closed)
+		assert.Equal(t, 0, instances[1].Closed)
+
+		// held instances are closed on shutdown
+		require.NoError(t, rt.shutdownComponents())
+		assert.Equal(t, 1, instances[1].Closed)
+		assert.Equal(t, 1, instances[2].Closed)
+	})
+}
+
+// recordingPubSub records the topics it subscribed to and its Close calls.
+type recordingPubSub struct {
+	topics []string
+	closed int
+}
+
+func (p *recordingPubSub) Init(metadata pubsub.Metadata) error {
+	return nil
+}
+
+func (p *recordingPubSub) Features() []pubsub.Feature {
+	return nil
+}
+
+func (p *recordingPubSub) Publish(req *pubsub.PublishRequest) error {
+	return nil
+}
+
+func (p *recordingPubSub) Subscribe(req pubsub.SubscribeRequest, handler pubsub.Handler) error {
+	p.topics = append(p.topics, req.Topic)
+	return nil
+}
+
+func (p *recordingPubSub) Close() error {
+	p.closed++
+	return nil
+}
+
+func TestInitConfigurationPlugin(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	store := plugin.NewMemoryConfigurationStore()
+	rt.plugins["pluginConfig"] = &daprt.MockPlugin{
+		InternalConfigurationStore: store,
+	}
+
+	c := components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pluginConfig",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "configuration.memory",
+			Version: "v1",
+			Plugin:  plugin.TypeGRPC,
+		},
+	}
+	err := rt.initConfiguration(c)
+	assert.NoError(t, err)
+	assert.Same(t, store, rt.configurationStores["pluginConfig"])
+
+	t.Run("plugin without configuration store", func(t *testing.T) {
+		rt.plugins["pluginConfig"] = &daprt.MockPlugin{}
+		err := rt.initConfiguration(c)
+		assert.Equal(t, plugin.ErrComponentNotImplemented, err)
+	})
+}
+
+func TestBuildHTTPPipelinePlugin(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	rt.components = append(rt.components, components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pluginMiddleware",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "middleware.http.header",
+			Version: "v1",
+			Plugin:  plugin.TypeGRPC,
+			Metadata: []components_v1alpha1.MetadataItem{
+				{Name: "header", Value: components_v1alpha1.DynamicValue{JSON: v1.JSON{Raw: []byte("X-Plugin")}}},
+				{Name: "value", Value: components_v1alpha1.DynamicValue{JSON: v1.JSON{Raw: []byte("test")}}},
+			},
+		},
+	})
+	rt.globalConfig.Spec.HTTPPipelineSpec.Handlers = []config.HandlerSpec{
+		{Name: "pluginMiddleware", Type: "middleware.http.header", Version: "v1"},
+	}
+
+	t.Run("middleware served by the plugin", func(t *testing.T) {
+		rt.plugins["pluginMiddleware"] = &daprt.MockPlugin{
+			InternalHTTPMiddleware: plugin.NewHeaderMiddleware(),
+		}
+		pipeline, err := rt.buildHTTPPipeline()
+		require.NoError(t, err)
+		require.Len(t, pipeline.Handlers, 1)
+
+		var header string
+		handler := pipeline.Apply(func(ctx *fasthttp.RequestCtx) {
+			header = string(ctx.Request.Header.Peek("X-Plugin"))
+		})
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&fasthttp.Request{}, nil, nil)
+		handler(&ctx)
+		assert.Equal(t, "test", header)
+	})
+
+	t.Run("plugin without http middleware", func(t *testing.T) {
+		rt.plugins["pluginMiddleware"] = &daprt.MockPlugin{}
+		_, err := rt.buildHTTPPipeline()
+		assert.Equal(t, plugin.ErrComponentNotImplemented, err)
+	})
+}
+
+func TestProcessPluginSecretStoreDependency(t *testing.T) {
+	secretsPlugin := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "secrets",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type: plugin.TypeGRPC,
+			Run: &plugins_v1alpha1.Run{
+				Name:    "secrets",
+				Version: "v1",
+			},
+			Components: []plugins_v1alpha1.Component{
+				{
+					Name:          "pluginSecretStore",
+					ComponentType: "secretstores",
+				},
+			},
+		},
+	}
+	// the metadata secrets of the state plugin are resolved from the secret store served by the secrets plugin
+	statePlugin := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "state",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type: plugin.TypeGRPC,
+			Run: &plugins_v1alpha1.Run{
+				Name:    "state",
+				Version: "v1",
+			},
+			Components: []plugins_v1alpha1.Component{
+				{
+					Name:          "pluginStore",
+					ComponentType: "state",
+				},
+			},
+			Metadata: []plugins_v1alpha1.MetadataItem{
+				{
+					Name: "password",
+					SecretKeyRef: plugins_v1alpha1.SecretKeyRef{
+						Name: "good-key",
+					},
+				},
+			},
+		},
+		Auth: plugins_v1alpha1.Auth{
+			SecretStore: "pluginSecretStore",
+		},
+	}
+	secretStoreComponent := components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pluginSecretStore",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "secretstores.fake",
+			Version: "v1",
+			Plugin:  plugin.TypeGRPC,
+		},
+	}
+
+	newRuntime := func() (*DaprRuntime, *[]string) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		created := []string{}
+		rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+			created = append(created, cfg.Name)
+			return &daprt.MockPlugin{
+				InternalStore:       plugin.NewMemoryStore(),
+				InternalSecretStore: daprt.FakeSecretStore{},
+			}, nil
+		}))
+		go rt.processComponents()
+		return rt, &created
+	}
+
+	t.Run("plugin waits for the secret store served by another plugin", func(t *testing.T) {
+		rt, created := newRuntime()
+		defer stopRuntime(t, rt)
+
+		rt.pendingPlugins <- statePlugin
+		rt.pendingComponents <- secretStoreComponent
+		rt.flushOutstandingComponents()
+		assert.Empty(t, *created)
+		assert.NotContains(t, rt.loadedPlugins, "state")
+
+		rt.pendingPlugins <- secretsPlugin
+		rt.flushOutstandingComponents()
+		assert.Equal(t, []string{"secrets", "state"}, *created)
+		assert.Contains(t, rt.secretStores, "pluginSecretStore")
+		assert.Contains(t, rt.loadedPlugins, "state")
+		assert.Contains(t, rt.plugins, "pluginStore")
+	})
+
+	t.Run("plugin loads when the secret store is already loaded", func(t *testing.T) {
+		rt, created := newRuntime()
+		defer stopRuntime(t, rt)
+
+		rt.pendingPlugins <- secretsPlugin
+		rt.pendingComponents <- secretStoreComponent
+		rt.pendingPlugins <- statePlugin
+		rt.flushOutstandingComponents()
+		assert.Equal(t, []string{"secrets", "state"}, *created)
+		assert.Contains(t, rt.loadedPlugins, "state")
+	})
+
+	t.Run("plugin can't resolve its secrets from a secret store it serves", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		defer stopRuntime(t, rt)
+
+		p := *secretsPlugin.DeepCopy()
+		p.Spec.Metadata = statePlugin.Spec.Metadata
+		p.Auth = statePlugin.Auth
+		err := rt.processPluginAndDependents(p)
+		assert.EqualError(t, err, "plugin secrets resolves its metadata secrets from secret store pluginSecretStore, which it serves itself")
+	})
+}
+
+func TestProcessPluginSecrets(t *testing.T) {
+	mockPlugin := plugins_v1alpha1.Plugin{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "mockPlugin",
+		},
+		Spec: plugins_v1alpha1.PluginSpec{
+			Type: plugin.TypeGRPC,
+			Metadata: []plugins_v1alpha1.MetadataItem{
+				{
+					Name: "a",
+					SecretKeyRef: plugins_v1alpha1.SecretKeyRef{
+						Key:  "key1",
+						Name: "name1",
+					},
+				},
+				{
+					Name: "b",
+					Value: plugins_v1alpha1.DynamicValue{
+						JSON: v1.JSON{Raw: []byte("value2")},
+					},
+				},
+			},
+		},
+		Auth: plugins_v1alpha1.Auth{
+			SecretStore: "kubernetes",
+		},
+	}
+
+	t.Run("Standalone Mode", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		defer stopRuntime(t, rt)
+		m := NewMockKubernetesStore()
+		rt.secretStoresRegistry.Register(
+			secretstores_loader.New("kubernetes", func() secretstores.SecretStore {
+				return m
+			}),
+		)
+
+		// add Kubernetes component manually
+		rt.processComponentAndDependents(components_v1alpha1.Component{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name: "kubernetes",
+			},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type:    "secretstores.kubernetes",
+				Version: "v1",
+			},
+		})
+
+		mod, unready, err := rt.processPluginSecrets(mockPlugin)
+		assert.NoError(t, err)
+		assert.Empty(t, unready)
+		assert.Equal(t, "value1", mod.Spec.Metadata[0].Value.String())
+		assert.Equal(t, "value2", mod.Spec.Metadata[1].Value.String())
+		// the plugin resource itself is left unresolved
+		assert.Empty(t, mockPlugin.Spec.Metadata[0].Value.Raw)
+	})
+
+	t.Run("Kubernetes Mode - secret populated by the operator", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.KubernetesMode)
+		defer stopRuntime(t, rt)
+		m := NewMockKubernetesStore()
+		rt.secretStoresRegistry.Register(
+			secretstores_loader.New("kubernetes", func() secretstores.SecretStore {
+				return m
+			}),
+		)
+		for _, comp := range rt.builtinSecretStore() {
+			err := rt.processComponentAndDependents(comp)
+			assert.Nil(t, err)
+		}
+
+		p := *mockPlugin.DeepCopy()
+		p.Auth.SecretStore = ""
+		enc, _ := json.Marshal(base64.StdEncoding.EncodeToString([]byte("operator value")))
+		p.Spec.Metadata[0].Value = plugins_v1alpha1.DynamicValue{
+			JSON: v1.JSON{Raw: enc},
+		}
+
+		mod, unready, err := rt.processPluginSecrets(p)
+		assert.NoError(t, err)
+		assert.Empty(t, unready)
+		assert.Equal(t, "operator value", mod.Spec.Metadata[0].Value.String())
+	})
+
+	t.Run("resolved metadata is passed to the plugin", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		defer stopRuntime(t, rt)
+		rt.secretStores["kubernetes"] = NewMockKubernetesStore()
+		instance := &daprt.MockPlugin{}
+		rt.pluginRegistry.Register(modes.StandaloneMode, plugin_loader.New(plugin.TypeGRPC, modes.StandaloneMode, func(cfg plugin.Config) (plugin.Plugin, error) {
+			return instance, nil
+		}))
+
+		err := rt.processPluginAndDependents(mockPlugin)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "value1", "b": "value2"}, instance.InitMetadata.Properties)
+	})
+}
+
+func TestPluginHealth(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	p := &daprt.MockPlugin{}
+	rt.plugins["pluginStore"] = p
+	assert.NoError(t, rt.pluginHealth())
+
+	p.HealthErr = errors.New("the plugin process exited")
+	assert.EqualError(t, rt.pluginHealth(), "pluginStore: the plugin process exited")
+}
+
 // Test InitSecretStore if secretstore.* refers to Kubernetes secret store.
 func TestInitSecretStoresInKubernetesMode(t *testing.T) {
 	rt := NewTestDaprRuntime(modes.KubernetesMode)
@@ -2758,7 +3474,7 @@ func TestInvokeOutputBindings(t *testing.T) {
 		rt := NewTestDaprRuntime(modes.StandaloneMode)
 		defer stopRuntime(t, rt)
 
-		_, err := rt.sendToOutputBinding("mockBinding", &bindings.InvokeRequest{
+		_, err := rt.sendToOutputBinding(context.Background(), "mockBinding", &bindings.InvokeRequest{
 			Data: []byte(""),
 		})
 		assert.NotNil(t, err)
@@ -2770,7 +3486,7 @@ func TestInvokeOutputBindings(t *testing.T) {
 		defer stopRuntime(t, rt)
 		rt.outputBindings["mockBinding"] = &mockBinding{}
 
-		_, err := rt.sendToOutputBinding("mockBinding", &bindings.InvokeRequest{
+		_, err := rt.sendToOutputBinding(context.Background(), "mockBinding", &bindings.InvokeRequest{
 			Data:      []byte(""),
 			Operation: bindings.CreateOperation,
 		})
@@ -2782,7 +3498,7 @@ func TestInvokeOutputBindings(t *testing.T) {
 		defer stopRuntime(t, rt)
 		rt.outputBindings["mockBinding"] = &mockBinding{}
 
-		_, err := rt.sendToOutputBinding("mockBinding", &bindings.InvokeRequest{
+		_, err := rt.sendToOutputBinding(context.Background(), "mockBinding", &bindings.InvokeRequest{
 			Data:      []byte(""),
 			Operation: bindings.GetOperation,
 		})
@@ -3130,6 +3846,56 @@ func TestInitBindings(t *testing.T) {
 		err = r.initBinding(output)
 		assert.NoError(t, err)
 	})
+
+	t.Run("output binding served by a plugin", func(t *testing.T) {
+		r := NewDaprRuntime(&Config{}, &config.Configuration{}, &config.AccessControlList{})
+		defer stopRuntime(t, r)
+		binding := &daprt.MockBinding{}
+		r.plugins["testplugin"] = &daprt.MockPlugin{
+			InternalOutputBinding: binding,
+		}
+
+		c := components_v1alpha1.Component{}
+		c.ObjectMeta.Name = "testplugin"
+		c.Spec.Type = "bindings.testplugin"
+		c.Spec.Plugin = plugin.TypeGRPC
+		err := r.initBinding(c)
+		assert.NoError(t, err)
+		assert.Same(t, binding, r.outputBindings["testplugin"])
+		assert.NotContains(t, r.inputBindings, "testplugin")
+	})
+
+	t.Run("input and output binding served by a plugin", func(t *testing.T) {
+		r := NewDaprRuntime(&Config{}, &config.Configuration{}, &config.AccessControlList{})
+		defer stopRuntime(t, r)
+		binding := &daprt.MockBinding{}
+		r.plugins["testplugin"] = &daprt.MockPlugin{
+			InternalInputBinding:  binding,
+			InternalOutputBinding: binding,
+		}
+
+		c := components_v1alpha1.Component{}
+		c.ObjectMeta.Name = "testplugin"
+		c.Spec.Type = "bindings.testplugin"
+		c.Spec.Plugin = plugin.TypeGRPC
+		err := r.initBinding(c)
+		assert.NoError(t, err)
+		assert.Same(t, binding, r.outputBindings["testplugin"])
+		assert.Same(t, binding, r.inputBindings["testplugin"])
+	})
+
+	t.Run("plugin without bindings", func(t *testing.T) {
+		r := NewDaprRuntime(&Config{}, &config.Configuration{}, &config.AccessControlList{})
+		defer stopRuntime(t, r)
+		r.plugins["testplugin"] = &daprt.MockPlugin{}
+
+		c := components_v1alpha1.Component{}
+		c.ObjectMeta.Name = "testplugin"
+		c.Spec.Type = "bindings.testplugin"
+		c.Spec.Plugin = plugin.TypeGRPC
+		err := r.initBinding(c)
+		assert.Error(t, err)
+	})
 }
 
 func TestActorReentrancyConfig(t *testing.T) {
@@ -0,0 +1,22 @@
+package sdk
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// streamInitialBackOff and streamMaxBackOff bound the exponential backoff between the attempts to reopen a plugin stream that broke.
+const (
+	streamInitialBackOff = 500 * time.Millisecond
+	streamMaxBackOff     = 10 * time.Second
+)
+
+// NewStreamBackOff returns the backoff between the attempts to reopen a plugin stream. It never gives up, the stream is reopened until its client is closed.
+func NewStreamBackOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = streamInitialBackOff
+	b.MaxInterval = streamMaxBackOff
+	b.MaxElapsedTime = 0
+	return b
+}
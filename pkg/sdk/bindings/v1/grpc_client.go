@@ -0,0 +1,306 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/dapr/components-contrib/bindings"
+	proto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GRPCOutputClient provides a grpc client for the output binding
+type GRPCOutputClient struct {
+	client proto.OutputBindingClient
+	// ctx is the parent of every plugin call, see WithContext
+	ctx     context.Context
+	timeout time.Duration
+	// init is shared with the copies made by WithContext
+	init *outputInit
+}
+
+// outputInit is the result of the last successful Init, which Reinit updates while other goroutines call the plugin
+type outputInit struct {
+	lock       sync.RWMutex
+	operations []bindings.OperationKind
+	// metadata is replayed by Reinit
+	metadata *bindings.Metadata
+}
+
+func NewGRPCOutputClient(client proto.OutputBindingClient) *GRPCOutputClient {
+	return &GRPCOutputClient{
+		client: client,
+		ctx:    context.Background(),
+		init:   &outputInit{},
+	}
+}
+
+// SetTimeout sets the timeout of each call to the plugin.
+func (c *GRPCOutputClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *GRPCOutputClient) WithContext(ctx context.Context) *GRPCOutputClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithContext binds the plugin calls of binding to ctx when the binding is served by a plugin. Other bindings are returned unchanged.
+func WithContext(ctx context.Context, binding bindings.OutputBinding) bindings.OutputBinding {
+	if c, ok := binding.(*GRPCOutputClient); ok {
+		return c.WithContext(ctx)
+	}
+	return binding
+}
+
+func (c *GRPCOutputClient) callContext() (context.Context, context.CancelFunc) {
+	return sdk.CallContext(c.ctx, c.timeout)
+}
+
+func (c *GRPCOutputClient) Init(metadata bindings.Metadata) error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Name:       metadata.Name,
+		Properties: metadata.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	// we need to call the method here because operations could return an error and the operations interface doesn't support errors
+	ctx, cancel = c.callContext()
+	defer cancel()
+	resp, err := c.client.Operations(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	operations := []bindings.OperationKind{}
+	for _, o := range resp.GetOperations() {
+		operations = append(operations, bindings.OperationKind(o))
+	}
+
+	c.init.lock.Lock()
+	defer c.init.lock.Unlock()
+	c.init.operations = operations
+	c.init.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCOutputClient) Reinit() error {
+	c.init.lock.RLock()
+	metadata := c.init.metadata
+	c.init.lock.RUnlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.Init(*metadata)
+}
+
+func (c *GRPCOutputClient) Invoke(req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	resp, err := c.client.Invoke(ctx, &proto.InvokeRequest{
+		Data:      req.Data,
+		Metadata:  req.Metadata,
+		Operation: string(req.Operation),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.InvokeResponse{
+		Data:     resp.GetData(),
+		Metadata: resp.GetMetadata(),
+	}, nil
+}
+
+func (c *GRPCOutputClient) Operations() []bindings.OperationKind {
+	c.init.lock.RLock()
+	defer c.init.lock.RUnlock()
+	return c.init.operations
+}
+
+// GRPCInputClient provides a grpc client for the input binding
+type GRPCInputClient struct {
+	client  proto.InputBindingClient
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+	// metadata and the read handler are replayed by Reinit
+	lock     sync.Mutex
+	metadata *bindings.Metadata
+	handler  func(*bindings.ReadResponse) ([]byte, error)
+	// reading is set while the events are read, and reinitialized is closed and replaced by Reinit,
+	// which wakes up the reading waiting to open its stream again
+	reading       bool
+	reinitialized chan struct{}
+}
+
+func NewGRPCInputClient(client proto.InputBindingClient) *GRPCInputClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &GRPCInputClient{
+		client:        client,
+		ctx:           ctx,
+		cancel:        cancel,
+		reinitialized: make(chan struct{}),
+	}
+}
+
+// SetTimeout sets the timeout of Init. Reading is not affected.
+func (c *GRPCInputClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+func (c *GRPCInputClient) Init(metadata bindings.Metadata) error {
+	ctx, cancel := sdk.CallContext(c.ctx, c.timeout)
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Name:       metadata.Name,
+		Properties: metadata.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin and resumes reading, which is needed after the plugin process restarted.
+func (c *GRPCInputClient) Reinit() error {
+	c.lock.Lock()
+	metadata := c.metadata
+	c.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	if err := c.Init(*metadata); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	close(c.reinitialized)
+	c.reinitialized = make(chan struct{})
+	if c.handler != nil && !c.reading {
+		go c.read(c.handler)
+	}
+	return nil
+}
+
+// Read streams the events of the binding to handler until the plugin stops reading or the client is closed.
+// Events are handled concurrently and each one is acknowledged with the handler result.
+// A stream that breaks is opened again with an exponential backoff.
+func (c *GRPCInputClient) Read(handler func(*bindings.ReadResponse) ([]byte, error)) error {
+	c.lock.Lock()
+	c.handler = handler
+	c.lock.Unlock()
+	return c.read(handler)
+}
+
+func (c *GRPCInputClient) read(handler func(*bindings.ReadResponse) ([]byte, error)) error {
+	c.lock.Lock()
+	if c.reading {
+		c.lock.Unlock()
+		return nil
+	}
+	c.reading = true
+	c.lock.Unlock()
+	defer func() {
+		c.lock.Lock()
+		c.reading = false
+		c.lock.Unlock()
+	}()
+
+	stream, err := c.client.Read(c.ctx)
+	if err != nil {
+		return err
+	}
+
+	b := sdk.NewStreamBackOff()
+	for {
+		received, err := c.relay(stream, handler)
+		if errors.Is(err, io.EOF) || c.ctx.Err() != nil {
+			return nil
+		}
+		if received {
+			b.Reset()
+		}
+		if stream = c.reopen(b); stream == nil {
+			return nil
+		}
+	}
+}
+
+// relay handles the events of the stream until it ends, and reports whether it received any.
+func (c *GRPCInputClient) relay(stream proto.InputBinding_ReadClient, handler func(*bindings.ReadResponse) ([]byte, error)) (bool, error) {
+	received := false
+	var sendLock sync.Mutex
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		go func(event *proto.ReadEvent) {
+			ack := &proto.ReadAck{
+				Id: event.Id,
+			}
+			data, err := handler(&bindings.ReadResponse{
+				Data:     event.Data,
+				Metadata: event.Metadata,
+			})
+			if err != nil {
+				ack.Error = err.Error()
+			}
+			ack.Data = data
+
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			stream.Send(ack)
+		}(event)
+	}
+}
+
+// reopen opens the stream again after the backoff, or right after Reinit, until it succeeds.
+// It returns nil once the client is closed.
+func (c *GRPCInputClient) reopen(b backoff.BackOff) proto.InputBinding_ReadClient {
+	for {
+		c.lock.Lock()
+		reinitialized := c.reinitialized
+		c.lock.Unlock()
+
+		select {
+		case <-c.ctx.Done():
+			return nil
+		case <-reinitialized:
+		case <-time.After(b.NextBackOff()):
+		}
+
+		stream, err := c.client.Read(c.ctx)
+		if err == nil {
+			return stream
+		}
+	}
+}
+
+func (c *GRPCInputClient) Close() error {
+	c.cancel()
+	return nil
+}
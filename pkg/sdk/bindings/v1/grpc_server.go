@@ -0,0 +1,142 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dapr/components-contrib/bindings"
+	bindingsv1pb "github.com/dapr/dapr/pkg/proto/bindings/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GRPCOutputServer struct {
+	// this is the real implementation
+	Impl bindings.OutputBinding
+}
+
+func (s *GRPCOutputServer) Init(ctx context.Context, req *bindingsv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := bindings.Metadata{
+		Name:       req.GetName(),
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+func (s *GRPCOutputServer) Invoke(ctx context.Context, req *bindingsv1pb.InvokeRequest) (*bindingsv1pb.InvokeResponse, error) {
+	resp, err := s.Impl.Invoke(&bindings.InvokeRequest{
+		Data:      req.GetData(),
+		Metadata:  req.GetMetadata(),
+		Operation: bindings.OperationKind(req.GetOperation()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return &bindingsv1pb.InvokeResponse{}, nil
+	}
+	return &bindingsv1pb.InvokeResponse{
+		Data:     resp.Data,
+		Metadata: resp.Metadata,
+	}, nil
+}
+
+func (s *GRPCOutputServer) Operations(ctx context.Context, req *emptypb.Empty) (*bindingsv1pb.OperationsResponse, error) {
+	operations := []string{}
+	for _, o := range s.Impl.Operations() {
+		operations = append(operations, string(o))
+	}
+	return &bindingsv1pb.OperationsResponse{
+		Operations: operations,
+	}, nil
+}
+
+type GRPCInputServer struct {
+	// this is the real implementation
+	Impl bindings.InputBinding
+}
+
+func (s *GRPCInputServer) Init(ctx context.Context, req *bindingsv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := bindings.Metadata{
+		Name:       req.GetName(),
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+// Read relays the events of the binding to the stream until the binding stops reading or the caller goes away.
+func (s *GRPCInputServer) Read(stream bindingsv1pb.InputBinding_ReadServer) error {
+	r := &reader{
+		stream:  stream,
+		pending: map[uint64]chan *bindingsv1pb.ReadAck{},
+	}
+	go r.receiveAcks()
+
+	// input bindings may read in the background and return right away, so the stream is kept open until the caller goes away
+	if err := s.Impl.Read(r.handle); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// reader relays the events of the binding to the stream and waits for their acks.
+type reader struct {
+	stream   bindingsv1pb.InputBinding_ReadServer
+	sendLock sync.Mutex
+
+	pendingLock sync.Mutex
+	pending     map[uint64]chan *bindingsv1pb.ReadAck
+	nextID      uint64
+}
+
+func (r *reader) receiveAcks() {
+	for {
+		ack, err := r.stream.Recv()
+		if err != nil {
+			return
+		}
+
+		r.pendingLock.Lock()
+		if result, ok := r.pending[ack.GetId()]; ok {
+			result <- ack
+		}
+		r.pendingLock.Unlock()
+	}
+}
+
+func (r *reader) handle(resp *bindings.ReadResponse) ([]byte, error) {
+	r.pendingLock.Lock()
+	r.nextID++
+	id := r.nextID
+	result := make(chan *bindingsv1pb.ReadAck, 1)
+	r.pending[id] = result
+	r.pendingLock.Unlock()
+
+	defer func() {
+		r.pendingLock.Lock()
+		delete(r.pending, id)
+		r.pendingLock.Unlock()
+	}()
+
+	r.sendLock.Lock()
+	err := r.stream.Send(&bindingsv1pb.ReadEvent{
+		Id:       id,
+		Data:     resp.Data,
+		Metadata: resp.Metadata,
+	})
+	r.sendLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case ack := <-result:
+		if ack.GetError() != "" {
+			return ack.GetData(), errors.New(ack.GetError())
+		}
+		return ack.GetData(), nil
+	case <-r.stream.Context().Done():
+		return nil, r.stream.Context().Err()
+	}
+}
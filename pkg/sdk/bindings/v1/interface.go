@@ -0,0 +1,58 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/bindings/v1"
+)
+
+const (
+	ProtocolGRPC = "bindings_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCBindingsPlugin{},
+}
+
+// CreatePluginMap serves the input and the output binding. Either binding can be nil when the plugin doesn't implement it.
+func CreatePluginMap(input bindings.InputBinding, output bindings.OutputBinding) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCBindingsPlugin{
+			Input:  input,
+			Output: output,
+		},
+	}
+}
+
+type GRPCBindingsPlugin struct {
+	plugin.Plugin
+	Input  bindings.InputBinding
+	Output bindings.OutputBinding
+}
+
+func (p *GRPCBindingsPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	if p.Input != nil {
+		proto.RegisterInputBindingServer(s, &GRPCInputServer{Impl: p.Input})
+	}
+	if p.Output != nil {
+		proto.RegisterOutputBindingServer(s, &GRPCOutputServer{Impl: p.Output})
+	}
+	return nil
+}
+
+func (p *GRPCBindingsPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &GRPCClients{
+		Input:  NewGRPCInputClient(proto.NewInputBindingClient(c)),
+		Output: NewGRPCOutputClient(proto.NewOutputBindingClient(c)),
+	}, nil
+}
+
+// GRPCClients holds the clients of the input and the output binding served by a plugin.
+type GRPCClients struct {
+	Input  *GRPCInputClient
+	Output *GRPCOutputClient
+}
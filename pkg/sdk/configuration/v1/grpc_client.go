@@ -0,0 +1,238 @@
+package configuration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/configuration"
+	proto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// GRPCClient provides a grpc client for the configuration store
+type GRPCClient struct {
+	client proto.ConfigurationStoreClient
+	// ctx is cancelled on Close and ends all open subscriptions
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+	// metadata is replayed by Reinit, and reinitialized is closed and replaced by Reinit,
+	// which wakes up the subscriptions waiting to subscribe again
+	lock          sync.Mutex
+	metadata      *configuration.Metadata
+	reinitialized chan struct{}
+	// subscriptions are the open subscriptions by id
+	subscriptionLock sync.Mutex
+	subscriptions    map[uint64]*configuration.SubscribeRequest
+	subscriptionID   uint64
+}
+
+func NewGRPCClient(client proto.ConfigurationStoreClient) *GRPCClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &GRPCClient{
+		client:        client,
+		ctx:           ctx,
+		cancel:        cancel,
+		reinitialized: make(chan struct{}),
+		subscriptions: map[uint64]*configuration.SubscribeRequest{},
+	}
+}
+
+// SetTimeout sets the timeout of Init and Get. Subscriptions are not affected.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+func (c *GRPCClient) Init(metadata configuration.Metadata) error {
+	ctx, cancel := sdk.CallContext(c.ctx, c.timeout)
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+// The open subscriptions are subscribed again right after.
+func (c *GRPCClient) Reinit() error {
+	c.lock.Lock()
+	metadata := c.metadata
+	c.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	if err := c.Init(*metadata); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	close(c.reinitialized)
+	c.reinitialized = make(chan struct{})
+	return nil
+}
+
+func (c *GRPCClient) Get(ctx context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	ctx, cancel := sdk.CallContext(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.Get(ctx, &proto.GetRequest{
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &configuration.GetResponse{
+		Items: fromProtoItems(resp.GetItems()),
+	}, nil
+}
+
+// Subscribe opens a subscription stream with the plugin and returns once the plugin accepted it.
+// Updates are passed to the handler until ctx is done, the plugin ends the subscription or the client is closed.
+// A stream that breaks is opened again with an exponential backoff.
+func (c *GRPCClient) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) error {
+	stream, cancel, err := c.subscribe(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	c.subscriptionID++
+	id := c.subscriptionID
+	c.subscriptions[id] = req
+	go c.receive(ctx, id, stream, cancel, req, handler)
+	return nil
+}
+
+// Subscriptions returns the number of open subscriptions.
+func (c *GRPCClient) Subscriptions() int {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	return len(c.subscriptions)
+}
+
+func (c *GRPCClient) unsubscribe(id uint64) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	delete(c.subscriptions, id)
+}
+
+// subscribe opens a subscription stream, which is cancelled with ctx, on Close or by the returned cancel function.
+func (c *GRPCClient) subscribe(ctx context.Context, req *configuration.SubscribeRequest) (proto.ConfigurationStore_SubscribeClient, context.CancelFunc, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.Subscribe(streamCtx, &proto.SubscribeRequest{
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	// the plugin sends the headers once the subscription is in place.
+	// a stream that ends without headers carries the subscribe error in its status.
+	md, err := stream.Header()
+	if err == nil && md == nil {
+		_, err = stream.Recv()
+		if err == nil {
+			err = errors.New("the plugin sent an update before accepting the subscription")
+		}
+	}
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-streamCtx.Done():
+		}
+	}()
+	return stream, cancel, nil
+}
+
+// receive passes the updates of the subscription to handler, and subscribes again when the stream breaks.
+// The subscription ends when its caller went away, the plugin closed the stream or the client is closed.
+func (c *GRPCClient) receive(ctx context.Context, id uint64, stream proto.ConfigurationStore_SubscribeClient, cancel context.CancelFunc, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) {
+	defer c.unsubscribe(id)
+	for {
+		err := c.relay(ctx, stream, handler)
+		cancel()
+		if errors.Is(err, io.EOF) || ctx.Err() != nil || c.ctx.Err() != nil {
+			return
+		}
+		if stream, cancel = c.resubscribe(ctx, req); stream == nil {
+			return
+		}
+	}
+}
+
+// relay passes the updates of the stream to handler until it ends.
+func (c *GRPCClient) relay(ctx context.Context, stream proto.ConfigurationStore_SubscribeClient, handler configuration.UpdateHandler) error {
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		handler(ctx, &configuration.UpdateEvent{
+			Items: fromProtoItems(event.GetItems()),
+		})
+	}
+}
+
+// resubscribe subscribes again with an exponential backoff, or right after Reinit, until it succeeds.
+// It returns a nil stream once ctx is done or the client is closed.
+func (c *GRPCClient) resubscribe(ctx context.Context, req *configuration.SubscribeRequest) (proto.ConfigurationStore_SubscribeClient, context.CancelFunc) {
+	b := sdk.NewStreamBackOff()
+	for {
+		c.lock.Lock()
+		reinitialized := c.reinitialized
+		c.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-c.ctx.Done():
+			return nil, nil
+		case <-reinitialized:
+		case <-time.After(b.NextBackOff()):
+		}
+
+		stream, cancel, err := c.subscribe(ctx, req)
+		if err == nil {
+			return stream, cancel
+		}
+	}
+}
+
+func (c *GRPCClient) Close() error {
+	c.cancel()
+	return nil
+}
+
+func fromProtoItems(items []*proto.Item) []*configuration.Item {
+	result := make([]*configuration.Item, 0, len(items))
+	for _, item := range items {
+		result = append(result, &configuration.Item{
+			Key:      item.GetKey(),
+			Value:    item.GetValue(),
+			Version:  item.GetVersion(),
+			Metadata: item.GetMetadata(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package configuration
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dapr/components-contrib/configuration"
+	configurationv1pb "github.com/dapr/dapr/pkg/proto/configuration/v1"
+	"google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl configuration.Store
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *configurationv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := configuration.Metadata{
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+func (s *GRPCServer) Get(ctx context.Context, req *configurationv1pb.GetRequest) (*configurationv1pb.GetResponse, error) {
+	resp, err := s.Impl.Get(ctx, &configuration.GetRequest{
+		Keys:     req.GetKeys(),
+		Metadata: req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &configurationv1pb.GetResponse{
+		Items: toProtoItems(resp.Items),
+	}, nil
+}
+
+// Subscribe relays the updates of the store to the stream until the caller goes away.
+func (s *GRPCServer) Subscribe(req *configurationv1pb.SubscribeRequest, stream configurationv1pb.ConfigurationStore_SubscribeServer) error {
+	var sendLock sync.Mutex
+	headerSent := false
+	err := s.Impl.Subscribe(stream.Context(), &configuration.SubscribeRequest{
+		Keys:     req.GetKeys(),
+		Metadata: req.GetMetadata(),
+	}, func(ctx context.Context, e *configuration.UpdateEvent) error {
+		sendLock.Lock()
+		defer sendLock.Unlock()
+		// sending an update implicitly sends the headers
+		headerSent = true
+		return stream.Send(&configurationv1pb.UpdateEvent{
+			Items: toProtoItems(e.Items),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	// let the client know the subscription is in place
+	sendLock.Lock()
+	if !headerSent {
+		headerSent = true
+		err = stream.SendHeader(metadata.MD{})
+	}
+	sendLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+func toProtoItems(items []*configuration.Item) []*configurationv1pb.Item {
+	result := make([]*configurationv1pb.Item, 0, len(items))
+	for _, item := range items {
+		result = append(result, &configurationv1pb.Item{
+			Key:      item.Key,
+			Value:    item.Value,
+			Version:  item.Version,
+			Metadata: item.Metadata,
+		})
+	}
+	return result
+}
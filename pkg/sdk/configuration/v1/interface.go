@@ -0,0 +1,41 @@
+package configuration
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/configuration/v1"
+)
+
+const (
+	ProtocolGRPC = "configuration_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCConfigurationPlugin{},
+}
+
+func CreatePluginMap(store configuration.Store) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCConfigurationPlugin{
+			Impl: store,
+		},
+	}
+}
+
+type GRPCConfigurationPlugin struct {
+	plugin.Plugin
+	Impl configuration.Store
+}
+
+func (p *GRPCConfigurationPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterConfigurationStoreServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCConfigurationPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewConfigurationStoreClient(c)), nil
+}
@@ -0,0 +1,34 @@
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/trace"
+
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+)
+
+// Reinitializer is implemented by the plugin clients to replay their initialization on a restarted plugin process.
+type Reinitializer interface {
+	Reinit() error
+}
+
+// CallContext returns the context for a single plugin call.
+// The call inherits the cancellation, deadline and trace span of parent and is bounded by timeout when it is greater than zero.
+func CallContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	ctx := parent
+	// the span of an http request is kept in the fasthttp user values, which derived contexts don't expose
+	if span := diag_utils.SpanFromContext(parent); span != nil {
+		ctx = trace.NewContext(parent, span)
+	}
+
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
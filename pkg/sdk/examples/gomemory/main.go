@@ -13,7 +13,6 @@ import (
 	"github.com/dapr/dapr/pkg/sdk"
 	sdk_state "github.com/dapr/dapr/pkg/sdk/state/v1"
 	"github.com/hashicorp/go-hclog"
-	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
 )
 
@@ -118,6 +117,11 @@ func (s *Store) Error(message string, args ...interface{}) error {
 }
 
 func (s *Store) BulkDelete(req []state.DeleteRequest) error {
+	for i := range req {
+		if err := s.Delete(&req[i]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -136,8 +140,11 @@ func (s *Store) BulkSet(req []state.SetRequest) error {
 }
 
 func main() {
-	port := 0
-	flag.IntVar(&port, "p", 0, "specifies the port to listen on (when in container)")
+	port, err := sdk.Port()
+	if err != nil {
+		handle(err)
+	}
+	flag.IntVar(&port, "p", port, "specifies the port to listen on (when in container)")
 	flag.Parse()
 	switch port {
 	case 0:
@@ -155,15 +162,7 @@ func servePlugin() {
 	store := &Store{
 		data: map[string][]byte{},
 	}
-	plugin.Serve(&plugin.ServeConfig{
-		HandshakeConfig: sdk.Handshake,
-		Plugins: plugin.PluginSet{
-			sdk_state.ProtocolRPC: &sdk_state.GRPCStatePlugin{
-				Impl: store,
-			},
-		},
-		GRPCServer: plugin.DefaultGRPCServer,
-	})
+	sdk.Serve(sdk_state.CreatePluginMap(store))
 }
 
 func serveGrpc(port int) error {
@@ -2,10 +2,30 @@ package sdk
 
 import "github.com/hashicorp/go-plugin"
 
+// Plugin protocol versions. The runtime negotiates the highest version supported by both sides when it loads a plugin.
+const (
+	// ProtocolVersion1 plugins serve a state store
+	ProtocolVersion1 = 1
+	// ProtocolVersion2 plugins serve any combination of state store and pubsub components
+	ProtocolVersion2 = 2
+	// ProtocolVersion3 plugins serve any combination of state store, pubsub and binding components
+	ProtocolVersion3 = 3
+	// ProtocolVersion4 plugins serve any combination of state store, pubsub, binding and secret store components
+	ProtocolVersion4 = 4
+	// ProtocolVersion5 plugins serve any combination of state store, pubsub, binding, secret store and configuration store components
+	ProtocolVersion5 = 5
+	// ProtocolVersion6 plugins serve any combination of state store, pubsub, binding, secret store, configuration store and http middleware components
+	ProtocolVersion6 = 6
+	// ProtocolVersion7 plugins additionally serve name resolution components
+	ProtocolVersion7 = 7
+	// ProtocolVersion is the protocol version served by plugins built with this sdk
+	ProtocolVersion = ProtocolVersion7
+)
+
 // Handshake is a common handshake that is shared by plugin and host.
 var Handshake = plugin.HandshakeConfig{
 	// This isn't required when using VersionedPlugins
-	ProtocolVersion:  1,
+	ProtocolVersion:  ProtocolVersion1,
 	MagicCookieKey:   "BASIC_PLUGIN",
 	MagicCookieValue: "76d3865e-360a-416a-bdf3-7f9891a4a2b8",
 }
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/valyala/fasthttp"
+
+	proto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// GRPCClient provides a grpc client for the http middleware
+type GRPCClient struct {
+	client  proto.HTTPMiddlewareClient
+	timeout time.Duration
+	// metadata is replayed by Reinit
+	lock     sync.Mutex
+	metadata *middleware.Metadata
+}
+
+func NewGRPCClient(client proto.HTTPMiddlewareClient) *GRPCClient {
+	return &GRPCClient{
+		client: client,
+	}
+}
+
+// SetTimeout sets the timeout of each call to the plugin.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+func (c *GRPCClient) init(metadata middleware.Metadata) error {
+	ctx, cancel := sdk.CallContext(context.Background(), c.timeout)
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last initialization on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCClient) Reinit() error {
+	c.lock.Lock()
+	metadata := c.metadata
+	c.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.init(*metadata)
+}
+
+// GetHandler initializes the middleware on the plugin and returns a handler passing every request to the plugin.
+// The request goes on with the changes of the plugin unless the plugin responds to it.
+func (c *GRPCClient) GetHandler(metadata middleware.Metadata) (func(h fasthttp.RequestHandler) fasthttp.RequestHandler, error) {
+	if err := c.init(metadata); err != nil {
+		return nil, err
+	}
+
+	return func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			callCtx, cancel := sdk.CallContext(ctx, c.timeout)
+			resp, err := c.client.Handle(callCtx, toProtoRequest(&ctx.Request))
+			cancel()
+			if err != nil {
+				ctx.Error(fmt.Sprintf("error calling middleware plugin: %s", err), fasthttp.StatusInternalServerError)
+				return
+			}
+
+			if next := resp.GetNext(); next != nil {
+				applyRequest(&ctx.Request, next)
+				h(ctx)
+				return
+			}
+			response := resp.GetResponse()
+			if response == nil {
+				ctx.Error("error calling middleware plugin: the plugin neither passed the request on nor responded to it", fasthttp.StatusInternalServerError)
+				return
+			}
+			applyResponse(&ctx.Response, response)
+		}
+	}, nil
+}
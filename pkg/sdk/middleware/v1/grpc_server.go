@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/valyala/fasthttp"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	middlewarev1pb "github.com/dapr/dapr/pkg/proto/middleware/v1"
+)
+
+// nextKey marks the requests the middleware passed on.
+const nextKey = "dapr.middleware.next"
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl middleware.Middleware
+
+	lock    sync.RWMutex
+	handler fasthttp.RequestHandler
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *middlewarev1pb.MetadataRequest) (*emptypb.Empty, error) {
+	m, err := s.Impl.GetHandler(middleware.Metadata{
+		Properties: req.GetProperties(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handler = m(func(ctx *fasthttp.RequestCtx) {
+		ctx.SetUserValue(nextKey, true)
+	})
+	return &emptypb.Empty{}, nil
+}
+
+// Handle runs the middleware on the request. A request the middleware passes on is returned as next, otherwise the middleware response is returned.
+func (s *GRPCServer) Handle(ctx context.Context, req *middlewarev1pb.HTTPRequest) (*middlewarev1pb.HandleResponse, error) {
+	s.lock.RLock()
+	handler := s.handler
+	s.lock.RUnlock()
+	if handler == nil {
+		return nil, errors.New("the middleware is not initialized")
+	}
+
+	var requestCtx fasthttp.RequestCtx
+	requestCtx.Init(&fasthttp.Request{}, nil, nil)
+	applyRequest(&requestCtx.Request, req)
+	handler(&requestCtx)
+
+	if next, _ := requestCtx.UserValue(nextKey).(bool); next {
+		return &middlewarev1pb.HandleResponse{
+			Result: &middlewarev1pb.HandleResponse_Next{
+				Next: toProtoRequest(&requestCtx.Request),
+			},
+		}, nil
+	}
+	return &middlewarev1pb.HandleResponse{
+		Result: &middlewarev1pb.HandleResponse_Response{
+			Response: toProtoResponse(&requestCtx.Response),
+		},
+	}, nil
+}
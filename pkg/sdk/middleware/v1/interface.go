@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+)
+
+const (
+	ProtocolGRPC = "middleware_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCMiddlewarePlugin{},
+}
+
+func CreatePluginMap(m middleware.Middleware) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCMiddlewarePlugin{
+			Impl: m,
+		},
+	}
+}
+
+type GRPCMiddlewarePlugin struct {
+	plugin.Plugin
+	Impl middleware.Middleware
+}
+
+func (p *GRPCMiddlewarePlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterHTTPMiddlewareServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCMiddlewarePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewHTTPMiddlewareClient(c)), nil
+}
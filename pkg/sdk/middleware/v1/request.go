@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/valyala/fasthttp"
+
+	proto "github.com/dapr/dapr/pkg/proto/middleware/v1"
+)
+
+// the content length follows the body, so it is never copied from the headers
+const contentLengthHeader = "Content-Length"
+
+func toProtoRequest(req *fasthttp.Request) *proto.HTTPRequest {
+	headers := []*proto.Header{}
+	req.Header.VisitAll(func(key, value []byte) {
+		headers = append(headers, &proto.Header{
+			Key:   string(key),
+			Value: string(value),
+		})
+	})
+	return &proto.HTTPRequest{
+		Method:  string(req.Header.Method()),
+		Uri:     string(req.Header.RequestURI()),
+		Headers: headers,
+		Body:    req.Body(),
+	}
+}
+
+// applyRequest replaces the method, uri, headers and body of req with the ones of the proto request.
+func applyRequest(req *fasthttp.Request, in *proto.HTTPRequest) {
+	req.Header.Reset()
+	req.Header.SetMethod(in.GetMethod())
+	req.SetRequestURI(in.GetUri())
+	for _, h := range in.GetHeaders() {
+		if strings.EqualFold(h.GetKey(), contentLengthHeader) {
+			continue
+		}
+		req.Header.Add(h.GetKey(), h.GetValue())
+	}
+	req.SetBody(in.GetBody())
+}
+
+func toProtoResponse(resp *fasthttp.Response) *proto.HTTPResponse {
+	headers := []*proto.Header{}
+	resp.Header.VisitAll(func(key, value []byte) {
+		headers = append(headers, &proto.Header{
+			Key:   string(key),
+			Value: string(value),
+		})
+	})
+	return &proto.HTTPResponse{
+		StatusCode: int32(resp.StatusCode()),
+		Headers:    headers,
+		Body:       resp.Body(),
+	}
+}
+
+// applyResponse replaces the status code, headers and body of resp with the ones of the proto response.
+func applyResponse(resp *fasthttp.Response, in *proto.HTTPResponse) {
+	resp.Header.Reset()
+	resp.SetStatusCode(int(in.GetStatusCode()))
+	for _, h := range in.GetHeaders() {
+		if strings.EqualFold(h.GetKey(), contentLengthHeader) {
+			continue
+		}
+		resp.Header.Add(h.GetKey(), h.GetValue())
+	}
+	resp.SetBody(in.GetBody())
+}
@@ -0,0 +1,116 @@
+package nameresolution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/nameresolution"
+	proto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// GRPCClient provides a grpc client for the name resolver
+type GRPCClient struct {
+	client  proto.ResolverClient
+	timeout time.Duration
+	// metadata is replayed by Reinit
+	lock     sync.Mutex
+	metadata *nameresolution.Metadata
+}
+
+func NewGRPCClient(client proto.ResolverClient) *GRPCClient {
+	return &GRPCClient{
+		client: client,
+	}
+}
+
+// SetTimeout sets the timeout of each call to the plugin.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+func (c *GRPCClient) callContext() (context.Context, context.CancelFunc) {
+	return sdk.CallContext(context.Background(), c.timeout)
+}
+
+func (c *GRPCClient) Init(metadata nameresolution.Metadata) error {
+	var configuration []byte
+	if metadata.Configuration != nil {
+		var err error
+		configuration, err = json.Marshal(normalize(metadata.Configuration))
+		if err != nil {
+			return fmt.Errorf("error encoding the name resolution configuration: %w", err)
+		}
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Properties:    metadata.Properties,
+		Configuration: configuration,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCClient) Reinit() error {
+	c.lock.Lock()
+	metadata := c.metadata
+	c.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.Init(*metadata)
+}
+
+func (c *GRPCClient) ResolveID(req nameresolution.ResolveRequest) (string, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	resp, err := c.client.ResolveID(ctx, &proto.ResolveRequest{
+		Id:        req.ID,
+		Namespace: req.Namespace,
+		Port:      int32(req.Port),
+		Data:      req.Data,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetAddress(), nil
+}
+
+// normalize converts the maps decoded from yaml configuration files, whose keys are not strings, so they can be encoded to JSON.
+func normalize(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[fmt.Sprint(key)] = normalize(value)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[key] = normalize(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = normalize(value)
+		}
+		return out
+	default:
+		return in
+	}
+}
@@ -0,0 +1,42 @@
+package nameresolution
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dapr/components-contrib/nameresolution"
+	nameresolutionv1pb "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl nameresolution.Resolver
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *nameresolutionv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := nameresolution.Metadata{
+		Properties: req.GetProperties(),
+	}
+	if len(req.GetConfiguration()) > 0 {
+		if err := json.Unmarshal(req.GetConfiguration(), &metadata.Configuration); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+func (s *GRPCServer) ResolveID(ctx context.Context, req *nameresolutionv1pb.ResolveRequest) (*nameresolutionv1pb.ResolveResponse, error) {
+	address, err := s.Impl.ResolveID(nameresolution.ResolveRequest{
+		ID:        req.GetId(),
+		Namespace: req.GetNamespace(),
+		Port:      int(req.GetPort()),
+		Data:      req.GetData(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nameresolutionv1pb.ResolveResponse{
+		Address: address,
+	}, nil
+}
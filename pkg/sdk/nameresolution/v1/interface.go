@@ -0,0 +1,41 @@
+package nameresolution
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/nameresolution"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/nameresolution/v1"
+)
+
+const (
+	ProtocolGRPC = "nameresolution_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCResolverPlugin{},
+}
+
+func CreatePluginMap(resolver nameresolution.Resolver) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCResolverPlugin{
+			Impl: resolver,
+		},
+	}
+}
+
+type GRPCResolverPlugin struct {
+	plugin.Plugin
+	Impl nameresolution.Resolver
+}
+
+func (p *GRPCResolverPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterResolverServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCResolverPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewResolverClient(c)), nil
+}
@@ -0,0 +1,64 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/configuration"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/dapr/dapr/pkg/proto/plugin/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// GRPCClient provides a grpc client for the plugin process
+type GRPCClient struct {
+	client  proto.PluginClient
+	timeout time.Duration
+	// metadata is replayed by Reinit
+	lock     sync.Mutex
+	metadata *configuration.Metadata
+}
+
+func NewGRPCClient(client proto.PluginClient) *GRPCClient {
+	return &GRPCClient{
+		client: client,
+	}
+}
+
+// SetTimeout sets the timeout of each call to the plugin.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// Init passes the metadata of the plugin resource to the plugin process.
+// Plugin processes that don't serve the plugin service take no metadata, so the call is skipped for them.
+func (c *GRPCClient) Init(metadata configuration.Metadata) error {
+	ctx, cancel := sdk.CallContext(context.Background(), c.timeout)
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCClient) Reinit() error {
+	c.lock.Lock()
+	metadata := c.metadata
+	c.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.Init(*metadata)
+}
@@ -0,0 +1,22 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/configuration"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	pluginv1pb "github.com/dapr/dapr/pkg/proto/plugin/v1"
+)
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl Initializer
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *pluginv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := configuration.Metadata{
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
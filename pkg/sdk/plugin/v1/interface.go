@@ -0,0 +1,46 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/configuration"
+	goplugin "github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/plugin/v1"
+)
+
+const (
+	ProtocolGRPC = "plugin_grpc"
+)
+
+// Initializer is implemented by plugin processes that are configured by the metadata of their plugin resource.
+type Initializer interface {
+	Init(metadata configuration.Metadata) error
+}
+
+var PluginMap = goplugin.PluginSet{
+	ProtocolGRPC: &GRPCInitializerPlugin{},
+}
+
+func CreatePluginMap(initializer Initializer) map[string]goplugin.Plugin {
+	return map[string]goplugin.Plugin{
+		ProtocolGRPC: &GRPCInitializerPlugin{
+			Impl: initializer,
+		},
+	}
+}
+
+type GRPCInitializerPlugin struct {
+	goplugin.Plugin
+	Impl Initializer
+}
+
+func (p *GRPCInitializerPlugin) GRPCServer(broker *goplugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterPluginServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCInitializerPlugin) GRPCClient(ctx context.Context, broker *goplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewPluginClient(c)), nil
+}
@@ -0,0 +1,268 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	proto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GRPCClient provides a grpc client for the pubsub
+type GRPCClient struct {
+	client proto.PubSubClient
+	// ctx is the parent of every unary plugin call, see WithContext
+	ctx     context.Context
+	timeout time.Duration
+	// state is shared with the copies made by WithContext
+	state *clientState
+}
+
+// clientState is the state of the client that Reinit and Close update while other goroutines call the plugin
+type clientState struct {
+	// ctx is cancelled on Close and ends all open subscriptions
+	ctx    context.Context
+	cancel context.CancelFunc
+	// lock guards the features, the metadata and reinitialized
+	lock     sync.RWMutex
+	features []pubsub.Feature
+	// metadata is replayed by Reinit
+	metadata *pubsub.Metadata
+	// reinitialized is closed and replaced by Reinit, which wakes up the subscriptions waiting to subscribe again
+	reinitialized chan struct{}
+}
+
+func NewGRPCClient(client proto.PubSubClient) *GRPCClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &GRPCClient{
+		client: client,
+		ctx:    context.Background(),
+		state: &clientState{
+			ctx:           ctx,
+			cancel:        cancel,
+			reinitialized: make(chan struct{}),
+		},
+	}
+}
+
+// SetTimeout sets the timeout of each unary call to the plugin. Subscriptions are not affected.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// WithContext returns a copy of the client whose unary plugin calls carry the deadline, cancellation and trace context of ctx.
+// Subscriptions stay open until the client is closed.
+func (c *GRPCClient) WithContext(ctx context.Context) *GRPCClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithContext binds the plugin calls of ps to ctx when the pubsub is served by a plugin. Other pubsubs are returned unchanged.
+func WithContext(ctx context.Context, ps pubsub.PubSub) pubsub.PubSub {
+	if c, ok := ps.(*GRPCClient); ok {
+		return c.WithContext(ctx)
+	}
+	return ps
+}
+
+func (c *GRPCClient) callContext() (context.Context, context.CancelFunc) {
+	return sdk.CallContext(c.ctx, c.timeout)
+}
+
+func (c *GRPCClient) Features() []pubsub.Feature {
+	c.state.lock.RLock()
+	defer c.state.lock.RUnlock()
+	return c.state.features
+}
+
+func (c *GRPCClient) Init(req pubsub.Metadata) error {
+	metadata := &proto.MetadataRequest{
+		Properties: map[string]string{},
+	}
+	for k, v := range req.Properties {
+		metadata.Properties[k] = v
+	}
+
+	// we need to call the method here because features could return an error and the features interface doesn't support errors
+	ctx, cancel := c.callContext()
+	defer cancel()
+	featureResponse, err := c.client.Features(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	features := []pubsub.Feature{}
+	for _, f := range featureResponse.Feature {
+		feature := pubsub.Feature(f)
+		features = append(features, feature)
+	}
+
+	ctx, cancel = c.callContext()
+	defer cancel()
+	if _, err = c.client.Init(ctx, metadata); err != nil {
+		return err
+	}
+
+	c.state.lock.Lock()
+	defer c.state.lock.Unlock()
+	c.state.features = features
+	c.state.metadata = &req
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+// The open subscriptions are subscribed again right after.
+func (c *GRPCClient) Reinit() error {
+	c.state.lock.RLock()
+	metadata := c.state.metadata
+	c.state.lock.RUnlock()
+
+	if metadata == nil {
+		return nil
+	}
+	if err := c.Init(*metadata); err != nil {
+		return err
+	}
+
+	c.state.lock.Lock()
+	defer c.state.lock.Unlock()
+	close(c.state.reinitialized)
+	c.state.reinitialized = make(chan struct{})
+	return nil
+}
+
+func (c *GRPCClient) Publish(req *pubsub.PublishRequest) error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Publish(ctx, &proto.PublishRequest{
+		Data:       req.Data,
+		PubsubName: req.PubsubName,
+		Topic:      req.Topic,
+		Metadata:   req.Metadata,
+	})
+	return err
+}
+
+// Subscribe opens a subscription stream with the plugin and returns once the plugin accepted it.
+// Messages are handled concurrently and each one is acknowledged with the handler result.
+// A stream that breaks is opened again with an exponential backoff until the client is closed.
+func (c *GRPCClient) Subscribe(req pubsub.SubscribeRequest, handler pubsub.Handler) error {
+	stream, err := c.subscribe(req)
+	if err != nil {
+		return err
+	}
+	go c.receive(stream, req, handler)
+	return nil
+}
+
+func (c *GRPCClient) subscribe(req pubsub.SubscribeRequest) (proto.PubSub_SubscribeClient, error) {
+	stream, err := c.client.Subscribe(c.state.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&proto.SubscribeStreamRequest{
+		Request: &proto.SubscribeStreamRequest_Subscribe{
+			Subscribe: &proto.SubscribeRequest{
+				Topic:    req.Topic,
+				Metadata: req.Metadata,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the plugin sends the headers once the subscription is in place.
+	// a stream that ends without headers carries the subscribe error in its status.
+	md, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+	if md == nil {
+		_, err = stream.Recv()
+		if err == nil {
+			err = errors.New("the plugin sent a message before accepting the subscription")
+		}
+		return nil, err
+	}
+	return stream, nil
+}
+
+// receive relays the messages of the subscription to handler, and subscribes again when the stream breaks.
+func (c *GRPCClient) receive(stream proto.PubSub_SubscribeClient, req pubsub.SubscribeRequest, handler pubsub.Handler) {
+	for stream != nil {
+		c.relay(stream, handler)
+		stream = c.resubscribe(req)
+	}
+}
+
+// relay handles the messages of the stream until it ends.
+func (c *GRPCClient) relay(stream proto.PubSub_SubscribeClient, handler pubsub.Handler) {
+	var sendLock sync.Mutex
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return
+		}
+
+		go func(msg *proto.Message) {
+			ack := &proto.MessageAck{
+				Id: msg.Id,
+			}
+			err := handler(c.state.ctx, &pubsub.NewMessage{
+				Data:     msg.Data,
+				Topic:    msg.Topic,
+				Metadata: msg.Metadata,
+			})
+			if err != nil {
+				ack.Error = err.Error()
+			}
+
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			stream.Send(&proto.SubscribeStreamRequest{
+				Request: &proto.SubscribeStreamRequest_Ack{
+					Ack: ack,
+				},
+			})
+		}(msg)
+	}
+}
+
+// resubscribe subscribes again with an exponential backoff, or right after Reinit, until it succeeds.
+// It returns nil once the client is closed.
+func (c *GRPCClient) resubscribe(req pubsub.SubscribeRequest) proto.PubSub_SubscribeClient {
+	b := sdk.NewStreamBackOff()
+	for {
+		c.state.lock.RLock()
+		reinitialized := c.state.reinitialized
+		c.state.lock.RUnlock()
+
+		select {
+		case <-c.state.ctx.Done():
+			return nil
+		case <-reinitialized:
+		case <-time.After(b.NextBackOff()):
+		}
+
+		stream, err := c.subscribe(req)
+		if err == nil {
+			return stream
+		}
+		if c.state.ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *GRPCClient) Close() error {
+	c.state.cancel()
+	return nil
+}
@@ -0,0 +1,224 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/dapr/components-contrib/pubsub"
+	pubsubv1pb "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+	"google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ErrSubscriptionClosed is returned by the handler of a subscription whose stream ended.
+var ErrSubscriptionClosed = errors.New("the subscription stream is closed")
+
+// ContextSubscriber is implemented by the pubsubs that stop delivering the messages of a subscription once its context is done.
+// The other pubsubs are subscribed once per topic and their messages go to the stream currently subscribed to the topic.
+// Their handler returns ErrSubscriptionClosed while no stream is subscribed to the topic.
+type ContextSubscriber interface {
+	SubscribeWithContext(ctx context.Context, req pubsub.SubscribeRequest, handler pubsub.Handler) error
+}
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl pubsub.PubSub
+
+	// lock guards the topics subscribed on an Impl that isn't a ContextSubscriber and the streams subscribed to them
+	lock    sync.Mutex
+	topics  map[string]bool
+	streams map[string]*subscription
+}
+
+func (s *GRPCServer) Features(ctx context.Context, req *emptypb.Empty) (*pubsubv1pb.FeaturesResponse, error) {
+	features := s.Impl.Features()
+	featureList := []string{}
+	for _, f := range features {
+		featureList = append(featureList, string(f))
+	}
+	return &pubsubv1pb.FeaturesResponse{
+		Feature: featureList,
+	}, nil
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *pubsubv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := pubsub.Metadata{
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+func (s *GRPCServer) Publish(ctx context.Context, req *pubsubv1pb.PublishRequest) (*emptypb.Empty, error) {
+	publishRequest := &pubsub.PublishRequest{
+		Data:       req.GetData(),
+		PubsubName: req.GetPubsubName(),
+		Topic:      req.GetTopic(),
+		Metadata:   req.GetMetadata(),
+	}
+	return &emptypb.Empty{}, s.Impl.Publish(publishRequest)
+}
+
+func (s *GRPCServer) Subscribe(stream pubsubv1pb.PubSub_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	subscribe := req.GetSubscribe()
+	if subscribe == nil {
+		return fmt.Errorf("expected the first request to be a subscribe request")
+	}
+
+	sub := &subscription{
+		stream:  stream,
+		pending: map[uint64]chan string{},
+	}
+	subscribeRequest := pubsub.SubscribeRequest{
+		Topic:    subscribe.GetTopic(),
+		Metadata: subscribe.GetMetadata(),
+	}
+	// the subscription ends with the stream
+	if subscriber, ok := s.Impl.(ContextSubscriber); ok {
+		err = subscriber.SubscribeWithContext(stream.Context(), subscribeRequest, sub.handle)
+	} else {
+		err = s.route(subscribeRequest, sub)
+		defer s.unroute(subscribeRequest.Topic, sub)
+	}
+	if err != nil {
+		return err
+	}
+	// let the client know the subscription is in place
+	if err = sub.sendHeader(); err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if ack := req.GetAck(); ack != nil {
+			sub.ack(ack)
+		}
+	}
+}
+
+// route sends the messages of the topic to the subscription, and subscribes Impl to the topic the first time.
+func (s *GRPCServer) route(req pubsub.SubscribeRequest, sub *subscription) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.topics == nil {
+		s.topics = map[string]bool{}
+		s.streams = map[string]*subscription{}
+	}
+
+	if !s.topics[req.Topic] {
+		err := s.Impl.Subscribe(req, func(ctx context.Context, msg *pubsub.NewMessage) error {
+			s.lock.Lock()
+			current, ok := s.streams[req.Topic]
+			s.lock.Unlock()
+			if !ok {
+				return ErrSubscriptionClosed
+			}
+			return current.handle(ctx, msg)
+		})
+		if err != nil {
+			return err
+		}
+		s.topics[req.Topic] = true
+	}
+	s.streams[req.Topic] = sub
+	return nil
+}
+
+// unroute stops sending the messages of the topic to the subscription once its stream ended.
+func (s *GRPCServer) unroute(topic string, sub *subscription) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.streams[topic] == sub {
+		delete(s.streams, topic)
+	}
+}
+
+// subscription relays the messages of a single topic subscription to the stream and waits for their acks.
+type subscription struct {
+	stream     pubsubv1pb.PubSub_SubscribeServer
+	sendLock   sync.Mutex
+	headerSent bool
+
+	pendingLock sync.Mutex
+	pending     map[uint64]chan string
+	nextID      uint64
+}
+
+func (s *subscription) sendHeader() error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	if s.headerSent {
+		return nil
+	}
+	s.headerSent = true
+	return s.stream.SendHeader(metadata.MD{})
+}
+
+func (s *subscription) send(msg *pubsubv1pb.Message) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	// sending a message implicitly sends the headers
+	s.headerSent = true
+	return s.stream.Send(msg)
+}
+
+func (s *subscription) handle(ctx context.Context, msg *pubsub.NewMessage) error {
+	if s.stream.Context().Err() != nil {
+		return ErrSubscriptionClosed
+	}
+
+	s.pendingLock.Lock()
+	s.nextID++
+	id := s.nextID
+	result := make(chan string, 1)
+	s.pending[id] = result
+	s.pendingLock.Unlock()
+
+	defer func() {
+		s.pendingLock.Lock()
+		delete(s.pending, id)
+		s.pendingLock.Unlock()
+	}()
+
+	err := s.send(&pubsubv1pb.Message{
+		Id:       id,
+		Data:     msg.Data,
+		Topic:    msg.Topic,
+		Metadata: msg.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case errMessage := <-result:
+		if errMessage != "" {
+			return errors.New(errMessage)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.stream.Context().Done():
+		return ErrSubscriptionClosed
+	}
+}
+
+func (s *subscription) ack(ack *pubsubv1pb.MessageAck) {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+	if result, ok := s.pending[ack.GetId()]; ok {
+		result <- ack.GetError()
+	}
+}
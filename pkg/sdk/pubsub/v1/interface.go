@@ -0,0 +1,41 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/pubsub/v1"
+)
+
+const (
+	ProtocolGRPC = "pubsub_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCPubSubPlugin{},
+}
+
+func CreatePluginMap(pubSub pubsub.PubSub) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCPubSubPlugin{
+			Impl: pubSub,
+		},
+	}
+}
+
+type GRPCPubSubPlugin struct {
+	plugin.Plugin
+	Impl pubsub.PubSub
+}
+
+func (p *GRPCPubSubPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterPubSubServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCPubSubPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewPubSubClient(c)), nil
+}
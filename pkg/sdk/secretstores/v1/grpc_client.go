@@ -0,0 +1,121 @@
+package secretstores
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	proto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
+	"github.com/dapr/dapr/pkg/sdk"
+)
+
+// GRPCClient provides a grpc client for the secret store
+type GRPCClient struct {
+	client proto.SecretStoreClient
+	// ctx is the parent of every plugin call, see WithContext
+	ctx     context.Context
+	timeout time.Duration
+	// init is shared with the copies made by WithContext
+	init *clientInit
+}
+
+// clientInit is the result of the last successful Init, which Reinit replays after the plugin process restarted
+type clientInit struct {
+	lock     sync.Mutex
+	metadata *secretstores.Metadata
+}
+
+func NewGRPCClient(client proto.SecretStoreClient) *GRPCClient {
+	return &GRPCClient{
+		client: client,
+		ctx:    context.Background(),
+		init:   &clientInit{},
+	}
+}
+
+// SetTimeout sets the timeout of each call to the plugin.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *GRPCClient) WithContext(ctx context.Context) *GRPCClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithContext binds the plugin calls of store to ctx when the secret store is served by a plugin. Other stores are returned unchanged.
+func WithContext(ctx context.Context, store secretstores.SecretStore) secretstores.SecretStore {
+	if c, ok := store.(*GRPCClient); ok {
+		return c.WithContext(ctx)
+	}
+	return store
+}
+
+func (c *GRPCClient) callContext() (context.Context, context.CancelFunc) {
+	return sdk.CallContext(c.ctx, c.timeout)
+}
+
+func (c *GRPCClient) Init(metadata secretstores.Metadata) error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Init(ctx, &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.init.lock.Lock()
+	defer c.init.lock.Unlock()
+	c.init.metadata = &metadata
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCClient) Reinit() error {
+	c.init.lock.Lock()
+	metadata := c.init.metadata
+	c.init.lock.Unlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.Init(*metadata)
+}
+
+func (c *GRPCClient) GetSecret(req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	resp, err := c.client.GetSecret(ctx, &proto.GetSecretRequest{
+		Name:     req.Name,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return secretstores.GetSecretResponse{}, err
+	}
+	return secretstores.GetSecretResponse{
+		Data: resp.GetData(),
+	}, nil
+}
+
+func (c *GRPCClient) BulkGetSecret(req secretstores.BulkGetSecretRequest) (secretstores.BulkGetSecretResponse, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	resp, err := c.client.BulkGetSecret(ctx, &proto.BulkGetSecretRequest{
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return secretstores.BulkGetSecretResponse{}, err
+	}
+
+	data := map[string]map[string]string{}
+	for name, secret := range resp.GetData() {
+		data[name] = secret.GetSecrets()
+	}
+	return secretstores.BulkGetSecretResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package secretstores
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/secretstores"
+	secretstoresv1pb "github.com/dapr/dapr/pkg/proto/secretstores/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GRPCServer struct {
+	// this is the real implementation
+	Impl secretstores.SecretStore
+}
+
+func (s *GRPCServer) Init(ctx context.Context, req *secretstoresv1pb.MetadataRequest) (*emptypb.Empty, error) {
+	metadata := secretstores.Metadata{
+		Properties: req.GetProperties(),
+	}
+	return &emptypb.Empty{}, s.Impl.Init(metadata)
+}
+
+func (s *GRPCServer) GetSecret(ctx context.Context, req *secretstoresv1pb.GetSecretRequest) (*secretstoresv1pb.GetSecretResponse, error) {
+	resp, err := s.Impl.GetSecret(secretstores.GetSecretRequest{
+		Name:     req.GetName(),
+		Metadata: req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &secretstoresv1pb.GetSecretResponse{
+		Data: resp.Data,
+	}, nil
+}
+
+func (s *GRPCServer) BulkGetSecret(ctx context.Context, req *secretstoresv1pb.BulkGetSecretRequest) (*secretstoresv1pb.BulkGetSecretResponse, error) {
+	resp, err := s.Impl.BulkGetSecret(secretstores.BulkGetSecretRequest{
+		Metadata: req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]*secretstoresv1pb.SecretResponse{}
+	for name, secret := range resp.Data {
+		data[name] = &secretstoresv1pb.SecretResponse{
+			Secrets: secret,
+		}
+	}
+	return &secretstoresv1pb.BulkGetSecretResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package secretstores
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	proto "github.com/dapr/dapr/pkg/proto/secretstores/v1"
+)
+
+const (
+	ProtocolGRPC = "secretstores_grpc"
+)
+
+var PluginMap = plugin.PluginSet{
+	ProtocolGRPC: &GRPCSecretStorePlugin{},
+}
+
+func CreatePluginMap(store secretstores.SecretStore) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		ProtocolGRPC: &GRPCSecretStorePlugin{
+			Impl: store,
+		},
+	}
+}
+
+type GRPCSecretStorePlugin struct {
+	plugin.Plugin
+	Impl secretstores.SecretStore
+}
+
+func (p *GRPCSecretStorePlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	proto.RegisterSecretStoreServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+func (p *GRPCSecretStorePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewGRPCClient(proto.NewSecretStoreClient(c)), nil
+}
@@ -1,19 +1,48 @@
 package sdk
 
 import (
+	"os"
+	"strconv"
+
 	"github.com/hashicorp/go-plugin"
 )
 
+// PortEnvVar is the environment variable holding the port a plugin container listens on.
+// The sidecar injector runs the plugins of the dapr.io/plugins annotation as containers of the pod, and passes each its port both as this variable and as the -p argument.
+// A plugin launched by daprd as a child process has no port and serves through Serve instead.
+const PortEnvVar = "DAPR_PLUGIN_PORT"
+
+// Port returns the port the plugin container listens on, 0 when the plugin is not run as a container.
+func Port() (int, error) {
+	port, ok := os.LookupEnv(PortEnvVar)
+	if !ok {
+		return 0, nil
+	}
+	return strconv.Atoi(port)
+}
+
 type Server interface {
 	// Server should return the RPC server compatible struct to serve
 	// the methods that the Client calls over net/rpc.
 	Server(*plugin.MuxBroker) (interface{}, error)
 }
 
-func Serve(pluginSet plugin.PluginSet) {
+// Serve serves the components of all plugin sets from a single plugin process, e.g. a state store and a pubsub.
+// Only the grpc plugins of the sets are served.
+func Serve(pluginSets ...plugin.PluginSet) {
+	pluginSet := plugin.PluginSet{}
+	for _, s := range pluginSets {
+		for k, v := range s {
+			if _, ok := v.(plugin.GRPCPlugin); ok {
+				pluginSet[k] = v
+			}
+		}
+	}
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake,
-		Plugins:         pluginSet,
-		GRPCServer:      plugin.DefaultGRPCServer,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			ProtocolVersion: pluginSet,
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
 	})
 }
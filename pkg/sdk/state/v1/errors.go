@@ -0,0 +1,90 @@
+package state
+
+import (
+	"errors"
+	"net/rpc"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errorDomain             = "dapr.io"
+	errorReasonETagMismatch = "ETAG_MISMATCH"
+	errorReasonETagInvalid  = "ETAG_INVALID"
+)
+
+var etagErrorKinds = map[string]state.ETagErrorKind{
+	errorReasonETagMismatch: state.ETagMismatch,
+	errorReasonETagInvalid:  state.ETagInvalid,
+}
+
+// toGRPCError encodes etag errors returned by the store as a status the client can turn back into a state.ETagError.
+// The status codes match the ones the Dapr API returns for etag errors.
+func toGRPCError(err error) error {
+	var etagErr *state.ETagError
+	if err == nil || !errors.As(err, &etagErr) {
+		return err
+	}
+
+	code, reason := codes.Aborted, errorReasonETagMismatch
+	if etagErr.Kind() == state.ETagInvalid {
+		code, reason = codes.InvalidArgument, errorReasonETagInvalid
+	}
+	st, detailErr := status.New(code, etagErr.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+	if detailErr != nil {
+		return status.Error(code, etagErr.Error())
+	}
+	return st.Err()
+}
+
+// fromGRPCError restores the state.ETagError encoded by toGRPCError.
+func fromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != errorDomain {
+			continue
+		}
+		if kind, ok := etagErrorKinds[info.Reason]; ok {
+			return newETagError(kind, st.Message())
+		}
+	}
+	return err
+}
+
+// fromRPCError restores etag errors from a net/rpc server error, which only carries the error message.
+func fromRPCError(err error) error {
+	serverErr, ok := err.(rpc.ServerError)
+	if !ok {
+		return err
+	}
+	for _, kind := range etagErrorKinds {
+		if strings.HasPrefix(string(serverErr), state.NewETagError(kind, nil).Error()) {
+			return newETagError(kind, string(serverErr))
+		}
+	}
+	return err
+}
+
+// newETagError creates an etag error from the message of a remote etag error without repeating its prefix.
+func newETagError(kind state.ETagErrorKind, message string) *state.ETagError {
+	message = strings.TrimPrefix(message, state.NewETagError(kind, nil).Error())
+	message = strings.TrimPrefix(message, ": ")
+	if message == "" {
+		return state.NewETagError(kind, nil)
+	}
+	return state.NewETagError(kind, errors.New(message))
+}
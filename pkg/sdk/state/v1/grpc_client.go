@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/components-contrib/state/utils"
 	"github.com/dapr/dapr/pkg/proto/common/v1"
 	proto "github.com/dapr/dapr/pkg/proto/state/v1"
+	"github.com/dapr/dapr/pkg/sdk"
 
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -18,18 +21,129 @@ import (
 
 // GRPCClient provides a grpc client for the state store
 type GRPCClient struct {
-	client   proto.StoreClient
+	client proto.StoreClient
+	// ctx is the parent of every plugin call, see WithContext
+	ctx     context.Context
+	timeout time.Duration
+	// init is shared with the copies made by WithContext
+	init *clientInit
+}
+
+// clientInit is the result of the last successful Init, which Reinit updates while other goroutines call the plugin
+type clientInit struct {
+	lock     sync.RWMutex
 	features []state.Feature
+	// metadata is replayed by Reinit
+	metadata *state.Metadata
 }
 
-func NewGRPCClient(client proto.StoreClient) state.Store {
+func NewGRPCClient(client proto.StoreClient) *GRPCClient {
 	return &GRPCClient{
 		client: client,
+		ctx:    context.Background(),
+		init:   &clientInit{},
+	}
+}
+
+// FeatureQueryAPI is advertised by the plugins whose state store implements state.Querier
+const FeatureQueryAPI state.Feature = "QUERY_API"
+
+// TransactionalGRPCClient provides a grpc client for a state store that advertises state.FeatureTransactional
+type TransactionalGRPCClient struct {
+	*GRPCClient
+}
+
+// QuerierGRPCClient provides a grpc client for a state store that advertises FeatureQueryAPI
+type QuerierGRPCClient struct {
+	*GRPCClient
+}
+
+// TransactionalQuerierGRPCClient provides a grpc client for a state store that advertises both state.FeatureTransactional and FeatureQueryAPI
+type TransactionalQuerierGRPCClient struct {
+	*TransactionalGRPCClient
+}
+
+// NewStore loads the features of the plugin and returns the client as a state.TransactionalStore only when the plugin advertises state.FeatureTransactional,
+// and as a state.Querier only when the plugin advertises FeatureQueryAPI.
+func NewStore(client *GRPCClient) (state.Store, error) {
+	if err := client.loadFeatures(); err != nil {
+		return nil, err
+	}
+	features := client.Features()
+	transactional := state.FeatureTransactional.IsPresent(features)
+	querier := FeatureQueryAPI.IsPresent(features)
+	switch {
+	case transactional && querier:
+		return &TransactionalQuerierGRPCClient{TransactionalGRPCClient: &TransactionalGRPCClient{GRPCClient: client}}, nil
+	case transactional:
+		return &TransactionalGRPCClient{GRPCClient: client}, nil
+	case querier:
+		return &QuerierGRPCClient{GRPCClient: client}, nil
+	}
+	return client, nil
+}
+
+// SetTimeout sets the timeout of each call to the plugin. A timeout of zero leaves the calls bounded by their context only.
+func (c *GRPCClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *GRPCClient) WithContext(ctx context.Context) *GRPCClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *TransactionalGRPCClient) WithContext(ctx context.Context) *TransactionalGRPCClient {
+	return &TransactionalGRPCClient{GRPCClient: c.GRPCClient.WithContext(ctx)}
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *QuerierGRPCClient) WithContext(ctx context.Context) *QuerierGRPCClient {
+	return &QuerierGRPCClient{GRPCClient: c.GRPCClient.WithContext(ctx)}
+}
+
+// WithContext returns a copy of the client whose plugin calls carry the deadline, cancellation and trace context of ctx.
+func (c *TransactionalQuerierGRPCClient) WithContext(ctx context.Context) *TransactionalQuerierGRPCClient {
+	return &TransactionalQuerierGRPCClient{TransactionalGRPCClient: c.TransactionalGRPCClient.WithContext(ctx)}
+}
+
+// WithContext binds the plugin calls of store to ctx when the store is served by a plugin. Other stores are returned unchanged.
+func WithContext(ctx context.Context, store state.Store) state.Store {
+	switch c := store.(type) {
+	case *GRPCClient:
+		return c.WithContext(ctx)
+	case *TransactionalGRPCClient:
+		return c.WithContext(ctx)
+	case *QuerierGRPCClient:
+		return c.WithContext(ctx)
+	case *TransactionalQuerierGRPCClient:
+		return c.WithContext(ctx)
 	}
+	return store
+}
+
+// WithTransactionalContext binds the plugin calls of a transactional store to ctx, like WithContext.
+func WithTransactionalContext(ctx context.Context, store state.TransactionalStore) state.TransactionalStore {
+	switch c := store.(type) {
+	case *TransactionalGRPCClient:
+		return c.WithContext(ctx)
+	case *TransactionalQuerierGRPCClient:
+		return c.WithContext(ctx)
+	}
+	return store
+}
+
+func (c *GRPCClient) callContext() (context.Context, context.CancelFunc) {
+	return sdk.CallContext(c.ctx, c.timeout)
 }
 
 func (c *GRPCClient) Features() []state.Feature {
-	return c.features
+	c.init.lock.RLock()
+	defer c.init.lock.RUnlock()
+	return c.init.features
 }
 
 func (c *GRPCClient) Init(req state.Metadata) error {
@@ -41,35 +155,71 @@ func (c *GRPCClient) Init(req state.Metadata) error {
 	}
 
 	// we need to call the method here because features could return an error and the features interface doesn't support errors
-	featureResponse, err := c.client.Features(context.TODO(), &emptypb.Empty{})
+	if err := c.loadFeatures(); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+	if _, err := c.client.Init(ctx, metadata); err != nil {
+		return err
+	}
+	c.init.lock.Lock()
+	defer c.init.lock.Unlock()
+	c.init.metadata = &req
+	return nil
+}
+
+func (c *GRPCClient) loadFeatures() error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	featureResponse, err := c.client.Features(ctx, &emptypb.Empty{})
 	if err != nil {
 		return err
 	}
 
-	c.features = []state.Feature{}
+	features := []state.Feature{}
 	for _, f := range featureResponse.Feature {
 		feature := state.Feature(f)
-		c.features = append(c.features, feature)
+		features = append(features, feature)
 	}
 
-	_, err = c.client.Init(context.TODO(), metadata)
-	return err
+	c.init.lock.Lock()
+	defer c.init.lock.Unlock()
+	c.init.features = features
+	return nil
+}
+
+// Reinit replays the last Init on the plugin, which is needed after the plugin process restarted.
+func (c *GRPCClient) Reinit() error {
+	c.init.lock.RLock()
+	metadata := c.init.metadata
+	c.init.lock.RUnlock()
+
+	if metadata == nil {
+		return nil
+	}
+	return c.Init(*metadata)
 }
 
 func (c *GRPCClient) getConsistency(value string) common.StateOptions_StateConsistency {
-	consistency, ok := common.StateOptions_StateConsistency_value[value]
-	if !ok {
-		return common.StateOptions_CONSISTENCY_UNSPECIFIED
+	switch value {
+	case state.Eventual:
+		return common.StateOptions_CONSISTENCY_EVENTUAL
+	case state.Strong:
+		return common.StateOptions_CONSISTENCY_STRONG
 	}
-	return common.StateOptions_StateConsistency(consistency)
+	return common.StateOptions_CONSISTENCY_UNSPECIFIED
 }
 
 func (c *GRPCClient) getConcurrency(value string) common.StateOptions_StateConcurrency {
-	concurrency, ok := common.StateOptions_StateConcurrency_value[value]
-	if !ok {
-		return common.StateOptions_CONCURRENCY_UNSPECIFIED
+	switch value {
+	case state.FirstWrite:
+		return common.StateOptions_CONCURRENCY_FIRST_WRITE
+	case state.LastWrite:
+		return common.StateOptions_CONCURRENCY_LAST_WRITE
 	}
-	return common.StateOptions_StateConcurrency(concurrency)
+	return common.StateOptions_CONCURRENCY_UNSPECIFIED
 }
 
 func (c *GRPCClient) Get(req *state.GetRequest) (*state.GetResponse, error) {
@@ -81,7 +231,9 @@ func (c *GRPCClient) Get(req *state.GetRequest) (*state.GetResponse, error) {
 		Data:     []byte{},
 	}
 
-	response, err := c.client.Get(context.TODO(), c.mapGetRequest(req))
+	ctx, cancel := c.callContext()
+	defer cancel()
+	response, err := c.client.Get(ctx, c.mapGetRequest(req))
 	if err != nil {
 		return emptyResponse, err
 	}
@@ -93,14 +245,10 @@ func (c *GRPCClient) Get(req *state.GetRequest) (*state.GetResponse, error) {
 }
 
 func (c *GRPCClient) mapGetRequest(req *state.GetRequest) *proto.GetRequest {
-	consistency, ok := common.StateOptions_StateConsistency_value[req.Key]
-	if !ok {
-		consistency = int32(common.StateOptions_CONSISTENCY_UNSPECIFIED)
-	}
 	return &proto.GetRequest{
 		Key:         req.Key,
 		Metadata:    req.Metadata,
-		Consistency: common.StateOptions_StateConsistency(consistency),
+		Consistency: c.getConsistency(req.Options.Consistency),
 	}
 }
 
@@ -121,55 +269,83 @@ func (c *GRPCClient) Set(req *state.SetRequest) error {
 	if err != nil {
 		return err
 	}
-	_, err = c.client.Set(context.TODO(), protoRequest)
-	return err
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err = c.client.Set(ctx, protoRequest)
+	return fromGRPCError(err)
 }
 
 func (c *GRPCClient) Ping() error {
 	empty := &emptypb.Empty{}
-	_, err := c.client.Ping(context.TODO(), empty)
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Ping(ctx, empty)
 	return err
 }
 
 func (c *GRPCClient) Delete(req *state.DeleteRequest) error {
-	request := &proto.DeleteRequest{
-		Key: req.GetKey(),
-		Etag: &common.Etag{
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Delete(ctx, c.mapDeleteRequest(req))
+	return fromGRPCError(err)
+}
+
+func (c *GRPCClient) mapDeleteRequest(req *state.DeleteRequest) *proto.DeleteRequest {
+	var etag *common.Etag
+	if req.ETag != nil {
+		etag = &common.Etag{
 			Value: *req.ETag,
-		},
+		}
+	}
+	return &proto.DeleteRequest{
+		Key:      req.GetKey(),
+		Etag:     etag,
 		Metadata: req.GetMetadata(),
 		Options: &common.StateOptions{
 			Concurrency: c.getConcurrency(req.Options.Concurrency),
 			Consistency: c.getConsistency(req.Options.Consistency),
 		},
 	}
-	_, err := c.client.Delete(context.TODO(), request)
-	return err
 }
 
 func (c *GRPCClient) BulkDelete(req []state.DeleteRequest) error {
-	return nil
+	requests := make([]*proto.DeleteRequest, 0, len(req))
+	for i := range req {
+		requests = append(requests, c.mapDeleteRequest(&req[i]))
+	}
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.BulkDelete(ctx, &proto.BulkDeleteRequest{
+		Items: requests,
+	})
+	return fromGRPCError(err)
 }
 
 func (c *GRPCClient) BulkGet(req []state.GetRequest) (bool, []state.BulkGetResponse, error) {
 	protoRequests := []*proto.GetRequest{}
-	for _, request := range req {
-		protoRequest := c.mapGetRequest(&request)
+	for i := range req {
+		protoRequest := c.mapGetRequest(&req[i])
 		protoRequests = append(protoRequests, protoRequest)
 	}
 	bulkGetRequest := &proto.BulkGetRequest{
 		Items: protoRequests,
 	}
-	bulkGetResponse, err := c.client.BulkGet(context.TODO(), bulkGetRequest)
+	ctx, cancel := c.callContext()
+	defer cancel()
+	bulkGetResponse, err := c.client.BulkGet(ctx, bulkGetRequest)
 	if err != nil {
 		return false, nil, err
 	}
 	items := []state.BulkGetResponse{}
 	for _, resp := range bulkGetResponse.Items {
+		var etag *string
+		if resp.Etag != nil {
+			etag = &resp.Etag.Value
+		}
 		bulkGet := state.BulkGetResponse{
 			Key:      resp.GetKey(),
 			Data:     resp.GetData(),
-			ETag:     &resp.GetEtag().Value,
+			ETag:     etag,
 			Metadata: resp.GetMetadata(),
 			Error:    resp.Error,
 		}
@@ -180,18 +356,20 @@ func (c *GRPCClient) BulkGet(req []state.GetRequest) (bool, []state.BulkGetRespo
 
 func (c *GRPCClient) BulkSet(req []state.SetRequest) error {
 	requests := []*proto.SetRequest{}
-	for _, r := range req {
-		protoRequest, err := c.mapSetRequest(&r)
+	for i := range req {
+		protoRequest, err := c.mapSetRequest(&req[i])
 		if err != nil {
 			return err
 		}
 		requests = append(requests, protoRequest)
 	}
 	var err error
-	_, err = c.client.BulkSet(context.TODO(), &proto.BulkSetRequest{
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err = c.client.BulkSet(ctx, &proto.BulkSetRequest{
 		Items: requests,
 	})
-	return err
+	return fromGRPCError(err)
 }
 
 func (c *GRPCClient) mapSetRequest(req *state.SetRequest) (*proto.SetRequest, error) {
@@ -227,3 +405,107 @@ func (c *GRPCClient) mapSetRequest(req *state.SetRequest) (*proto.SetRequest, er
 		},
 	}, nil
 }
+
+// Multi executes the transactional operations in the plugin.
+// The plugin can stop advertising state.FeatureTransactional when it is restarted with a new version, which is checked before each call.
+func (c *TransactionalGRPCClient) Multi(request *state.TransactionalStateRequest) error {
+	if !state.FeatureTransactional.IsPresent(c.Features()) {
+		return fmt.Errorf("the state plugin does not support transactions")
+	}
+
+	operations := make([]*proto.TransactionalStateOperation, 0, len(request.Operations))
+	for _, o := range request.Operations {
+		operation, err := c.mapTransactionalStateOperation(o)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, operation)
+	}
+	ctx, cancel := c.callContext()
+	defer cancel()
+	_, err := c.client.Multi(ctx, &proto.TransactionalStateRequest{
+		Operations: operations,
+		Metadata:   request.Metadata,
+	})
+	return fromGRPCError(err)
+}
+
+func (c *GRPCClient) mapTransactionalStateOperation(operation state.TransactionalStateOperation) (*proto.TransactionalStateOperation, error) {
+	switch req := operation.Request.(type) {
+	case state.SetRequest:
+		return c.mapTransactionalSetRequest(&req)
+	case *state.SetRequest:
+		return c.mapTransactionalSetRequest(req)
+	case state.DeleteRequest:
+		return c.mapTransactionalDeleteRequest(&req), nil
+	case *state.DeleteRequest:
+		return c.mapTransactionalDeleteRequest(req), nil
+	default:
+		return nil, fmt.Errorf("unsupported request type %T for operation %s", operation.Request, operation.Operation)
+	}
+}
+
+func (c *GRPCClient) mapTransactionalSetRequest(req *state.SetRequest) (*proto.TransactionalStateOperation, error) {
+	protoRequest, err := c.mapSetRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.TransactionalStateOperation{
+		Request: &proto.TransactionalStateOperation_Set{
+			Set: protoRequest,
+		},
+	}, nil
+}
+
+func (c *GRPCClient) mapTransactionalDeleteRequest(req *state.DeleteRequest) *proto.TransactionalStateOperation {
+	return &proto.TransactionalStateOperation{
+		Request: &proto.TransactionalStateOperation_Delete{
+			Delete: c.mapDeleteRequest(req),
+		},
+	}
+}
+
+// Query sends the query to the plugin.
+func (c *QuerierGRPCClient) Query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	return c.query(req)
+}
+
+// Query sends the query to the plugin.
+func (c *TransactionalQuerierGRPCClient) Query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	return c.query(req)
+}
+
+func (c *GRPCClient) query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	q, err := marshalQuery(&req.Query)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.callContext()
+	defer cancel()
+	response, err := c.client.Query(ctx, &proto.QueryRequest{
+		Query:    string(q),
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]state.QueryItem, 0, len(response.Items))
+	for _, item := range response.Items {
+		var etag *string
+		if item.Etag != nil {
+			etag = &item.Etag.Value
+		}
+		results = append(results, state.QueryItem{
+			Key:   item.GetKey(),
+			Data:  item.GetData(),
+			ETag:  etag,
+			Error: item.GetError(),
+		})
+	}
+	return &state.QueryResponse{
+		Results:  results,
+		Token:    response.GetToken(),
+		Metadata: response.GetMetadata(),
+	}, nil
+}
@@ -2,11 +2,14 @@ package state
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/proto/common/v1"
 	statev1pb "github.com/dapr/dapr/pkg/proto/state/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -21,6 +24,9 @@ func (s *GRPCServer) Features(ctx context.Context, req *emptypb.Empty) (*statev1
 	for _, f := range features {
 		featureList = append(featureList, string(f))
 	}
+	if _, ok := s.Impl.(state.Querier); ok && !FeatureQueryAPI.IsPresent(features) {
+		featureList = append(featureList, string(FeatureQueryAPI))
+	}
 	return &statev1pb.FeaturesResponse{
 		Feature: featureList,
 	}, nil
@@ -38,7 +44,7 @@ func (s *GRPCServer) Get(ctx context.Context, req *statev1pb.GetRequest) (*state
 		Key:      req.GetKey(),
 		Metadata: req.GetMetadata(),
 		Options: state.GetStateOption{
-			Consistency: req.Consistency.String(),
+			Consistency: consistencyToString(req.Consistency),
 		},
 	}
 	response, err := s.Impl.Get(request)
@@ -50,7 +56,9 @@ func (s *GRPCServer) Get(ctx context.Context, req *statev1pb.GetRequest) (*state
 	}
 	var etag *common.Etag
 	if response.ETag != nil {
-		etag.Value = *response.ETag
+		etag = &common.Etag{
+			Value: *response.ETag,
+		}
 	}
 
 	return &statev1pb.GetResponse{
@@ -61,38 +69,28 @@ func (s *GRPCServer) Get(ctx context.Context, req *statev1pb.GetRequest) (*state
 }
 
 func (s *GRPCServer) Set(ctx context.Context, req *statev1pb.SetRequest) (*emptypb.Empty, error) {
-	var etag *string
-	if req.Etag != nil {
-		etag = &req.Etag.Value
-	}
-	setRequest := &state.SetRequest{
-		Key:   req.Key,
-		ETag:  etag,
-		Value: req.Value,
-		Options: state.SetStateOption{
-			Concurrency: req.Options.GetConcurrency().String(),
-			Consistency: req.Options.GetConsistency().String(),
-		},
-	}
-	err := s.Impl.Set(setRequest)
-	return &emptypb.Empty{}, err
+	err := s.Impl.Set(s.mapSetRequest(req))
+	return &emptypb.Empty{}, toGRPCError(err)
 }
 
 func (s *GRPCServer) Delete(ctx context.Context, req *statev1pb.DeleteRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, toGRPCError(s.Impl.Delete(s.mapDeleteRequest(req)))
+}
+
+func (s *GRPCServer) mapDeleteRequest(req *statev1pb.DeleteRequest) *state.DeleteRequest {
 	var etag *string
 	if req.Etag != nil {
 		etag = &req.Etag.Value
 	}
-	deleteRequest := &state.DeleteRequest{
+	return &state.DeleteRequest{
 		Key:      req.Key,
 		ETag:     etag,
 		Metadata: req.Metadata,
 		Options: state.DeleteStateOption{
-			Concurrency: req.Options.GetConcurrency().String(),
-			Consistency: req.Options.GetConsistency().String(),
+			Concurrency: concurrencyToString(req.Options.GetConcurrency()),
+			Consistency: consistencyToString(req.Options.GetConsistency()),
 		},
 	}
-	return &emptypb.Empty{}, s.Impl.Delete(deleteRequest)
 }
 
 func (s *GRPCServer) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
@@ -100,7 +98,12 @@ func (s *GRPCServer) Ping(ctx context.Context, req *emptypb.Empty) (*emptypb.Emp
 }
 
 func (s *GRPCServer) BulkDelete(ctx context.Context, req *statev1pb.BulkDeleteRequest) (*emptypb.Empty, error) {
-	return nil, nil
+	requests := make([]state.DeleteRequest, 0, len(req.Items))
+	for _, protoRequest := range req.Items {
+		requests = append(requests, *s.mapDeleteRequest(protoRequest))
+	}
+	err := s.Impl.BulkDelete(requests)
+	return &emptypb.Empty{}, toGRPCError(err)
 }
 
 func (s *GRPCServer) BulkGet(ctx context.Context, req *statev1pb.BulkGetRequest) (*statev1pb.BulkGetResponse, error) {
@@ -110,7 +113,7 @@ func (s *GRPCServer) BulkGet(ctx context.Context, req *statev1pb.BulkGetRequest)
 			Key:      protoRequest.GetKey(),
 			Metadata: protoRequest.GetMetadata(),
 			Options: state.GetStateOption{
-				Consistency: protoRequest.Consistency.String(),
+				Consistency: consistencyToString(protoRequest.Consistency),
 			},
 		}
 		requests = append(requests, stateRequest)
@@ -145,20 +148,22 @@ func (s *GRPCServer) BulkGet(ctx context.Context, req *statev1pb.BulkGetRequest)
 }
 
 func (s *GRPCServer) mapSetRequest(stateSetRequest *statev1pb.SetRequest) *state.SetRequest {
-	etag := ""
+	var etag *string
 	if stateSetRequest.Etag != nil {
-		etag = stateSetRequest.Etag.Value
+		etag = &stateSetRequest.Etag.Value
 	}
 	return &state.SetRequest{
-		Key:   stateSetRequest.Key,
-		ETag:  &etag,
-		Value: stateSetRequest.Value,
+		Key:      stateSetRequest.Key,
+		ETag:     etag,
+		Value:    stateSetRequest.Value,
+		Metadata: stateSetRequest.Metadata,
 		Options: state.SetStateOption{
-			Concurrency: stateSetRequest.Options.GetConcurrency().String(),
-			Consistency: stateSetRequest.Options.GetConsistency().String(),
+			Concurrency: concurrencyToString(stateSetRequest.Options.GetConcurrency()),
+			Consistency: consistencyToString(stateSetRequest.Options.GetConsistency()),
 		},
 	}
 }
+
 func (s *GRPCServer) BulkSet(ctx context.Context, req *statev1pb.BulkSetRequest) (*emptypb.Empty, error) {
 	requests := []state.SetRequest{}
 	for _, protoSetRequest := range req.Items {
@@ -166,5 +171,98 @@ func (s *GRPCServer) BulkSet(ctx context.Context, req *statev1pb.BulkSetRequest)
 		requests = append(requests, *stateSetRequest)
 	}
 	err := s.Impl.BulkSet(requests)
-	return &emptypb.Empty{}, err
+	return &emptypb.Empty{}, toGRPCError(err)
+}
+
+func (s *GRPCServer) Multi(ctx context.Context, req *statev1pb.TransactionalStateRequest) (*emptypb.Empty, error) {
+	store, ok := s.Impl.(state.TransactionalStore)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the state store does not support transactions")
+	}
+
+	operations := make([]state.TransactionalStateOperation, 0, len(req.Operations))
+	for _, o := range req.Operations {
+		switch r := o.Request.(type) {
+		case *statev1pb.TransactionalStateOperation_Set:
+			operations = append(operations, state.TransactionalStateOperation{
+				Operation: state.Upsert,
+				Request:   *s.mapSetRequest(r.Set),
+			})
+		case *statev1pb.TransactionalStateOperation_Delete:
+			operations = append(operations, state.TransactionalStateOperation{
+				Operation: state.Delete,
+				Request:   *s.mapDeleteRequest(r.Delete),
+			})
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported transactional operation %T", o.Request)
+		}
+	}
+
+	err := store.Multi(&state.TransactionalStateRequest{
+		Operations: operations,
+		Metadata:   req.Metadata,
+	})
+	return &emptypb.Empty{}, toGRPCError(err)
+}
+
+func (s *GRPCServer) Query(ctx context.Context, req *statev1pb.QueryRequest) (*statev1pb.QueryResponse, error) {
+	querier, ok := s.Impl.(state.Querier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the state store does not support queries")
+	}
+
+	var request state.QueryRequest
+	if err := json.Unmarshal([]byte(req.GetQuery()), &request.Query); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed query: %s", err)
+	}
+	request.Metadata = req.GetMetadata()
+
+	response, err := querier.Query(&request)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, fmt.Errorf("response is nil")
+	}
+
+	items := make([]*statev1pb.QueryItem, 0, len(response.Results))
+	for _, result := range response.Results {
+		var etag *common.Etag
+		if result.ETag != nil {
+			etag = &common.Etag{
+				Value: *result.ETag,
+			}
+		}
+		items = append(items, &statev1pb.QueryItem{
+			Key:   result.Key,
+			Data:  result.Data,
+			Etag:  etag,
+			Error: result.Error,
+		})
+	}
+	return &statev1pb.QueryResponse{
+		Items:    items,
+		Token:    response.Token,
+		Metadata: response.Metadata,
+	}, nil
+}
+
+func consistencyToString(c common.StateOptions_StateConsistency) string {
+	switch c {
+	case common.StateOptions_CONSISTENCY_EVENTUAL:
+		return state.Eventual
+	case common.StateOptions_CONSISTENCY_STRONG:
+		return state.Strong
+	}
+	return ""
+}
+
+func concurrencyToString(c common.StateOptions_StateConcurrency) string {
+	switch c {
+	case common.StateOptions_CONCURRENCY_FIRST_WRITE:
+		return state.FirstWrite
+	case common.StateOptions_CONCURRENCY_LAST_WRITE:
+		return state.LastWrite
+	}
+	return ""
 }
@@ -43,7 +43,7 @@ func (p *GRPCStatePlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server)
 }
 
 func (p *GRPCStatePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
-	return &GRPCClient{client: proto.NewStoreClient(c)}, nil
+	return NewGRPCClient(proto.NewStoreClient(c)), nil
 }
 
 type RPCStatePlugin struct {
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dapr/components-contrib/state/query"
+)
+
+// marshalQuery encodes the query in the same JSON format the query API accepts.
+// The parsed filter is converted back because query.Query only keeps the raw filters when built in code.
+func marshalQuery(q *query.Query) ([]byte, error) {
+	m := map[string]interface{}{}
+	switch {
+	case q.Filter != nil:
+		filter, err := filterToMap(q.Filter)
+		if err != nil {
+			return nil, err
+		}
+		m[query.FILTER] = filter
+	case len(q.Filters) > 0:
+		m[query.FILTER] = q.Filters
+	}
+	if len(q.Sort) > 0 {
+		m[query.SORT] = q.Sort
+	}
+	if q.Page.Limit != 0 || q.Page.Token != "" {
+		m[query.PAGE] = q.Page
+	}
+	return json.Marshal(m)
+}
+
+func filterToMap(filter query.Filter) (map[string]interface{}, error) {
+	switch f := filter.(type) {
+	case *query.EQ:
+		return map[string]interface{}{
+			"EQ": map[string]interface{}{f.Key: f.Val},
+		}, nil
+	case *query.IN:
+		return map[string]interface{}{
+			"IN": map[string]interface{}{f.Key: f.Vals},
+		}, nil
+	case *query.AND:
+		filters, err := filtersToMaps(f.Filters)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"AND": filters}, nil
+	case *query.OR:
+		filters, err := filtersToMaps(f.Filters)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"OR": filters}, nil
+	default:
+		return nil, fmt.Errorf("unsupported filter type %T", filter)
+	}
+}
+
+func filtersToMaps(filters []query.Filter) ([]interface{}, error) {
+	maps := make([]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		m, err := filterToMap(filter)
+		if err != nil {
+			return nil, err
+		}
+		maps = append(maps, m)
+	}
+	return maps, nil
+}
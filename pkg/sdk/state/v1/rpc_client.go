@@ -7,13 +7,15 @@ import (
 )
 
 const (
-	GetMethod      = "Plugin.Get"
-	SetMethod      = "Plugin.Set"
-	DeleteMethod   = "Plugin.Delete"
-	InitMethod     = "Plugin.Init"
-	PingMethod     = "Plugin.Ping"
-	FeaturesMethod = "Plugin.Features"
-	BulkSetMethod  = "Plugin.BulkSet"
+	GetMethod        = "Plugin.Get"
+	SetMethod        = "Plugin.Set"
+	DeleteMethod     = "Plugin.Delete"
+	InitMethod       = "Plugin.Init"
+	PingMethod       = "Plugin.Ping"
+	FeaturesMethod   = "Plugin.Features"
+	BulkDeleteMethod = "Plugin.BulkDelete"
+	BulkGetMethod    = "Plugin.BulkGet"
+	BulkSetMethod    = "Plugin.BulkSet"
 )
 
 type RPCClient struct {
@@ -41,16 +43,22 @@ func (s *RPCClient) Features() []state.Feature {
 	return s.features
 }
 
+func (s *RPCClient) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	var resp state.GetResponse
+	if err := s.client.Call(GetMethod, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 func (s *RPCClient) Delete(req *state.DeleteRequest) error {
 	var resp interface{}
-	return s.client.Call(DeleteMethod, req, &resp)
+	return fromRPCError(s.client.Call(DeleteMethod, req, &resp))
 }
 
 func (s *RPCClient) Set(req *state.SetRequest) error {
 	var resp interface{}
-	return s.client.Call(SetMethod, map[string]interface{}{
-		"req": req,
-	}, &resp)
+	return fromRPCError(s.client.Call(SetMethod, req, &resp))
 }
 
 func (s *RPCClient) Ping() error {
@@ -63,16 +71,19 @@ func (s *RPCClient) Ping() error {
 }
 
 func (s *RPCClient) BulkDelete(req []state.DeleteRequest) error {
-	return nil
+	var resp interface{}
+	return fromRPCError(s.client.Call(BulkDeleteMethod, req, &resp))
 }
 
 func (s *RPCClient) BulkGet(req []state.GetRequest) (bool, []state.BulkGetResponse, error) {
-	return false, nil, nil
+	var resp BulkGetResponse
+	if err := s.client.Call(BulkGetMethod, req, &resp); err != nil {
+		return false, nil, err
+	}
+	return resp.Got, resp.Items, nil
 }
 
 func (s *RPCClient) BulkSet(req []state.SetRequest) error {
 	var resp interface{}
-	return s.client.Call(BulkSetMethod, map[string]interface{}{
-		"req": req,
-	}, &resp)
+	return fromRPCError(s.client.Call(BulkSetMethod, req, &resp))
 }
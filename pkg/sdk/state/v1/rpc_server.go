@@ -18,6 +18,17 @@ func (s *RPCServer) Features(req *interface{}, features *[]state.Feature) error
 	return nil
 }
 
+func (s *RPCServer) Get(req *state.GetRequest, resp *state.GetResponse) error {
+	response, err := s.Impl.Get(req)
+	if err != nil {
+		return err
+	}
+	if response != nil {
+		*resp = *response
+	}
+	return nil
+}
+
 func (s *RPCServer) Delete(req *state.DeleteRequest, resp *interface{}) error {
 	return s.Impl.Delete(req)
 }
@@ -30,6 +41,26 @@ func (s *RPCServer) Ping(args *interface{}, resp *interface{}) error {
 	return s.Impl.Ping()
 }
 
+func (s *RPCServer) BulkDelete(req *[]state.DeleteRequest, resp *interface{}) error {
+	return s.Impl.BulkDelete(*req)
+}
+
+// BulkGetResponse is the net/rpc reply of BulkGet
+type BulkGetResponse struct {
+	Got   bool
+	Items []state.BulkGetResponse
+}
+
+func (s *RPCServer) BulkGet(req *[]state.GetRequest, resp *BulkGetResponse) error {
+	got, items, err := s.Impl.BulkGet(*req)
+	if err != nil {
+		return err
+	}
+	resp.Got = got
+	resp.Items = items
+	return nil
+}
+
 func (s *RPCServer) BulkSet(req *[]state.SetRequest, resp *interface{}) error {
 	return s.Impl.BulkSet(*req)
 }
@@ -215,6 +215,52 @@ func (_m *MockActors) GetReminder(ctx context.Context, req *actors.GetReminderRe
 	return r0, r1
 }
 
+// ListReminders provides a mock function with given fields: req
+func (_m *MockActors) ListReminders(ctx context.Context, req *actors.ListRemindersRequest) (*actors.ListRemindersResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 *actors.ListRemindersResponse
+	if rf, ok := ret.Get(0).(func(*actors.ListRemindersRequest) *actors.ListRemindersResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*actors.ListRemindersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*actors.ListRemindersRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTimers provides a mock function with given fields: req
+func (_m *MockActors) ListTimers(ctx context.Context, req *actors.ListTimersRequest) (*actors.ListTimersResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 *actors.ListTimersResponse
+	if rf, ok := ret.Get(0).(func(*actors.ListTimersRequest) *actors.ListTimersResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*actors.ListTimersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*actors.ListTimersRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetActiveActorsCount provides a mock function
 func (_m *MockActors) GetActiveActorsCount(ctx context.Context) []actors.ActiveActorsCount {
 	_m.Called()
@@ -3,13 +3,38 @@ package testing
 import (
 	"strings"
 
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
+
+	"github.com/dapr/dapr/pkg/plugin"
 )
 
 type MockPlugin struct {
-	InternalStore state.Store
+	InternalStore  state.Store
+	InternalPubSub pubsub.PubSub
+	// InternalInputBinding and InternalOutputBinding are not implemented when nil
+	InternalInputBinding  bindings.InputBinding
+	InternalOutputBinding bindings.OutputBinding
+	// InternalSecretStore is not implemented when nil
+	InternalSecretStore secretstores.SecretStore
+	// InternalConfigurationStore is not implemented when nil
+	InternalConfigurationStore configuration.Store
+	// InternalHTTPMiddleware is not implemented when nil
+	InternalHTTPMiddleware middleware.Middleware
+	// InternalNameResolver is not implemented when nil
+	InternalNameResolver nameresolution.Resolver
+	HealthErr            error
+	// InitErr is returned by Init
+	InitErr error
+	// Closed is the number of Close calls
+	Closed int
+	// InitMetadata is the metadata of the last Init call
+	InitMetadata configuration.Metadata
 }
 
 func (p *MockPlugin) Name() string {
@@ -29,7 +54,8 @@ func (p *MockPlugin) FullName() string {
 }
 
 func (p *MockPlugin) Init(metadata configuration.Metadata) error {
-	return nil
+	p.InitMetadata = metadata
+	return p.InitErr
 }
 
 func (p *MockPlugin) Store() (state.Store, error) {
@@ -37,5 +63,56 @@ func (p *MockPlugin) Store() (state.Store, error) {
 }
 
 func (p *MockPlugin) PubSub() (pubsub.PubSub, error) {
-	return nil, nil
+	return p.InternalPubSub, nil
+}
+
+func (p *MockPlugin) InputBinding() (bindings.InputBinding, error) {
+	if p.InternalInputBinding == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalInputBinding, nil
+}
+
+func (p *MockPlugin) OutputBinding() (bindings.OutputBinding, error) {
+	if p.InternalOutputBinding == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalOutputBinding, nil
+}
+
+func (p *MockPlugin) SecretStore() (secretstores.SecretStore, error) {
+	if p.InternalSecretStore == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalSecretStore, nil
+}
+
+func (p *MockPlugin) ConfigurationStore() (configuration.Store, error) {
+	if p.InternalConfigurationStore == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalConfigurationStore, nil
+}
+
+func (p *MockPlugin) HTTPMiddleware() (middleware.Middleware, error) {
+	if p.InternalHTTPMiddleware == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalHTTPMiddleware, nil
+}
+
+func (p *MockPlugin) NameResolver() (nameresolution.Resolver, error) {
+	if p.InternalNameResolver == nil {
+		return nil, plugin.ErrComponentNotImplemented
+	}
+	return p.InternalNameResolver, nil
+}
+
+func (p *MockPlugin) Health() error {
+	return p.HealthErr
+}
+
+func (p *MockPlugin) Close() error {
+	p.Closed++
+	return nil
 }